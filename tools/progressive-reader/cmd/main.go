@@ -22,6 +22,9 @@ func main() {
 		continueFileFlag = flag.String("continue-file", "", "Path to continuation token file (TOON format)")
 		maxTokensFlag    = flag.Int("max-tokens", 2000, "Maximum tokens per chunk")
 		listFlag         = flag.Bool("list", false, "List all chunks without content")
+		validateFlag     = flag.Bool("validate", false, "Check that the chunks cover the file without gaps or overlaps")
+		unparsedFlag     = flag.Bool("mark-unparsed", false, "Type chunks with syntax errors \"unparsed\"")
+		perSymbolFlag    = flag.Bool("one-per-symbol", false, "Give every declaration its own chunk instead of packing small ones together")
 		versionFlag      = flag.Bool("version", false, "Show version")
 		helpFlag         = flag.Bool("help", false, "Show help message")
 	)
@@ -38,13 +41,13 @@ func main() {
 		os.Exit(0)
 	}
 
-	if err := run(*pathFlag, *chunkFlag, *continueFileFlag, *maxTokensFlag, *listFlag); err != nil {
+	if err := run(*pathFlag, *chunkFlag, *continueFileFlag, *maxTokensFlag, *listFlag, *validateFlag, *unparsedFlag, *perSymbolFlag); err != nil {
 		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
 		os.Exit(1)
 	}
 }
 
-func run(path string, chunkNum int, continueFile string, maxTokens int, list bool) error {
+func run(path string, chunkNum int, continueFile string, maxTokens int, list, validate, markUnparsed, onePerSymbol bool) error {
 	if continueFile != "" {
 		return handleContinuation(continueFile)
 	}
@@ -63,7 +66,7 @@ func run(path string, chunkNum int, continueFile string, maxTokens int, list boo
 		return fmt.Errorf("failed to read file: %w", err)
 	}
 
-	c, err := chunker.NewChunker(absPath, content, maxTokens)
+	c, err := chunker.NewChunkerWithOptions(absPath, content, chunkerOptions(maxTokens, markUnparsed, onePerSymbol))
 	if err != nil {
 		return fmt.Errorf("failed to create chunker: %w", err)
 	}
@@ -73,8 +76,12 @@ func run(path string, chunkNum int, continueFile string, maxTokens int, list boo
 		return fmt.Errorf("failed to chunk file: %w", err)
 	}
 
-	if len(chunks) == 0 {
-		return fmt.Errorf("no chunks generated")
+	if validate {
+		if err := chunker.ValidateChunks(chunks, c.LineCount()); err != nil {
+			return err
+		}
+		fmt.Printf("valid: %d chunks cover %d lines\n", len(chunks), c.LineCount())
+		return nil
 	}
 
 	if list {
@@ -83,6 +90,10 @@ func run(path string, chunkNum int, continueFile string, maxTokens int, list boo
 		return nil
 	}
 
+	if len(chunks) == 0 {
+		return fmt.Errorf("no chunks generated: empty input")
+	}
+
 	targetChunk := 0
 	if chunkNum >= 0 {
 		if chunkNum >= len(chunks) {
@@ -103,6 +114,7 @@ func run(path string, chunkNum int, continueFile string, maxTokens int, list boo
 			len(chunks),
 			content,
 		)
+		tok.MaxTokens, tok.MarkUnparsed, tok.OnePerSymbol = maxTokens, markUnparsed, onePerSymbol
 
 		if err := tok.SaveToFile(defaultTokenPath); err != nil {
 			return fmt.Errorf("failed to save continuation token: %w", err)
@@ -116,6 +128,18 @@ func run(path string, chunkNum int, continueFile string, maxTokens int, list boo
 	return nil
 }
 
+// chunkerOptions returns the chunker options for the chunking flags. A
+// continuation token records the flags, so a continued read chunks the file
+// as the read that saved the token did.
+func chunkerOptions(maxTokens int, markUnparsed, onePerSymbol bool) chunker.Options {
+	return chunker.Options{
+		MaxTokens:         maxTokens,
+		MarkUnparsed:      markUnparsed,
+		OneChunkPerSymbol: onePerSymbol,
+		PeekNext:          true,
+	}
+}
+
 func handleContinuation(continueFile string) error {
 	tok, err := token.LoadFromFile(continueFile)
 	if err != nil {
@@ -131,7 +155,7 @@ func handleContinuation(continueFile string) error {
 		return fmt.Errorf("file validation failed: %w", err)
 	}
 
-	c, err := chunker.NewChunker(tok.File, content, 2000)
+	c, err := chunker.NewChunkerWithOptions(tok.File, content, chunkerOptions(tok.MaxTokens, tok.MarkUnparsed, tok.OnePerSymbol))
 	if err != nil {
 		return fmt.Errorf("failed to create chunker: %w", err)
 	}
@@ -156,6 +180,7 @@ func handleContinuation(continueFile string) error {
 			len(chunks),
 			content,
 		)
+		newTok.MaxTokens, newTok.MarkUnparsed, newTok.OnePerSymbol = tok.MaxTokens, tok.MarkUnparsed, tok.OnePerSymbol
 
 		if err := newTok.SaveToFile(defaultTokenPath); err != nil {
 			return fmt.Errorf("failed to save continuation token: %w", err)
@@ -181,6 +206,9 @@ func printHelp() {
 	fmt.Println("  --continue-file <path>   Continue from previous read (TOON token file)")
 	fmt.Println("  --max-tokens <n>         Maximum tokens per chunk (default: 2000)")
 	fmt.Println("  --list                   List all chunks without content")
+	fmt.Println("  --validate               Check that the chunks cover the file without gaps or overlaps")
+	fmt.Println("  --mark-unparsed          Type chunks with syntax errors \"unparsed\"")
+	fmt.Println("  --one-per-symbol         Give every declaration its own chunk instead of packing small ones together")
 	fmt.Println("  --version                Show version")
 	fmt.Println("  --help                   Show this help message")
 	fmt.Println()
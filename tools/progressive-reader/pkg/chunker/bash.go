@@ -0,0 +1,60 @@
+package chunker
+
+import (
+	sitter "github.com/smacker/go-tree-sitter"
+)
+
+// Shell scripts are mostly top-level commands, so plain statements are
+// targets too; otherwise oversized scripts would only keep their functions.
+var bashSpec = astSpec{
+	targets: map[string]bool{
+		"function_definition":   true,
+		"compound_statement":    true,
+		"subshell":              true,
+		"if_statement":          true,
+		"case_statement":        true,
+		"for_statement":         true,
+		"c_style_for_statement": true,
+		"while_statement":       true,
+		"redirected_statement":  true,
+		"command":               true,
+		"pipeline":              true,
+		"list":                  true,
+		"declaration_command":   true,
+		"variable_assignment":   true,
+		"unset_command":         true,
+		"negated_command":       true,
+		"test_command":          true,
+	},
+	typeName: extractBashNodeType,
+	nodeName: extractBashNodeName,
+	descend:  true,
+}
+
+func (c *Chunker) chunkBash(tree *sitter.Tree) ([]Chunk, error) {
+	return c.chunkAST(tree, bashSpec)
+}
+
+func extractBashNodeType(nodeType string) string {
+	switch nodeType {
+	case "function_definition":
+		return "function"
+	case "compound_statement", "subshell":
+		return "block"
+	case "if_statement", "case_statement":
+		return "conditional"
+	case "for_statement", "c_style_for_statement", "while_statement":
+		return "loop"
+	default:
+		return "code"
+	}
+}
+
+// extractBashNodeName reads the function name from both the `name() { ... }`
+// and `function name { ... }` forms, which share the grammar's name field.
+func extractBashNodeName(node *sitter.Node, source string) string {
+	if node.Type() != "function_definition" {
+		return ""
+	}
+	return extractFieldName(node, source)
+}
@@ -0,0 +1,54 @@
+package chunker
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// benchmarkSource generates a Ruby file of about n lines: classes of ten
+// small methods each.
+func benchmarkSource(n int) []byte {
+	var b strings.Builder
+	for class, lines := 0, 0; lines < n; class++ {
+		fmt.Fprintf(&b, "class Widget%d\n", class)
+		for method := 0; method < 10; method++ {
+			fmt.Fprintf(&b, "  def step%d(value)\n    total = value * %d\n    total + 1\n  end\n\n", method, method)
+		}
+		b.WriteString("end\n\n")
+		lines += 52
+	}
+	return []byte(b.String())
+}
+
+// BenchmarkChunkFile chunks a 10k-line file, where building each chunk's
+// Content from line ranges rather than repeated joins matters.
+func BenchmarkChunkFile(b *testing.B) {
+	source := benchmarkSource(10000)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		c, err := NewChunker("widgets.rb", source, 200)
+		if err != nil {
+			b.Fatal(err)
+		}
+		if _, err := c.ChunkFile(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkChunkOutline is BenchmarkChunkFile for an outline, which walks
+// the same file without building chunk content.
+func BenchmarkChunkOutline(b *testing.B) {
+	source := benchmarkSource(10000)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		c, err := NewChunker("widgets.rb", source, 200)
+		if err != nil {
+			b.Fatal(err)
+		}
+		if _, err := c.ChunkOutline(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,41 @@
+package chunker
+
+import (
+	"bytes"
+	"unicode/utf8"
+)
+
+// binarySniffLen is how much of a file is inspected by looksBinary.
+const binarySniffLen = 8 * 1024
+
+// maxInvalidUTF8Ratio is the share of undecodable bytes above which content
+// is treated as binary even without NUL bytes.
+const maxInvalidUTF8Ratio = 0.3
+
+// looksBinary reports whether the start of content has a NUL byte, which text
+// files never contain, or is mostly invalid UTF-8.
+func looksBinary(content []byte) bool {
+	sample := content
+	truncated := len(sample) > binarySniffLen
+	if truncated {
+		sample = sample[:binarySniffLen]
+	}
+	if bytes.IndexByte(sample, 0) >= 0 {
+		return true
+	}
+
+	invalid, total := 0, 0
+	for i := 0; i < len(sample); {
+		// A rune cut off by the sample boundary isn't evidence of binary data
+		if truncated && len(sample)-i < utf8.UTFMax && !utf8.FullRune(sample[i:]) {
+			break
+		}
+		r, size := utf8.DecodeRune(sample[i:])
+		if r == utf8.RuneError && size == 1 {
+			invalid++
+		}
+		total++
+		i += size
+	}
+	return total > 0 && float64(invalid)/float64(total) > maxInvalidUTF8Ratio
+}
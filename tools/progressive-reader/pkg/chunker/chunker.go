@@ -1,8 +1,14 @@
 package chunker
 
 import (
+	"bytes"
+	"crypto/sha256"
 	"fmt"
+	"path/filepath"
+	"sort"
 	"strings"
+	"unicode"
+	"unicode/utf8"
 
 	sitter "github.com/smacker/go-tree-sitter"
 	"github.com/arpitnath/super-claude-kit/tools/progressive-reader/pkg/parser"
@@ -13,55 +19,471 @@ type Chunk struct {
 	StartLine    int
 	EndLine      int
 	Type         string
+	NodeType     string // tree-sitter node type Type was derived from, e.g. "method_definition"; empty for chunks found without a syntax tree
 	Name         string
+	Signature    string   // declaration header of the first node, without its body
+	Parent       string   // enclosing type or namespace, e.g. a Go method's receiver type
+	Parameters   []string // parameters of a function or method chunk, e.g. "id string"
+	ReturnType   string   // declared result of a function or method chunk, e.g. "(*User, error)"
+	References   []string // callees of the calls in a Go, TypeScript, JavaScript, Python or C# chunk, e.g. "fmt.Println"
 	Context      string
-	Depth        int // heading nesting depth for markdown (0 = top-level)
+	Breadcrumb   string      // markdown heading path, e.g. "Guide > Setup > Linux"
+	Depth        int         // heading nesting for markdown, declaration nesting for code (0 = top-level)
+	BaseIndent   int         // bytes of leading whitespace common to the chunk's source lines
+	Indent       string      // whitespace removed from each non-empty line by Options.Dedent; see Reindent
+	Marker       string      // header lines prepended to Content by Options.LineMarkers and PrependStyleImports; see Unmarked
+	Hash         string      // hex SHA-256 of the Unmarked content, for incremental indexing
+	StableID     string      // hex SHA-256 of file path, QualifiedName and Hash; see stableID
+	Tokens       int         // estimated token count of Unmarked content, as used for splitting
+	IsTest       bool        // the file is a test file or the chunk declares a test
+	Duplicates   []LineRange // lines of each identical chunk collapsed into this one by Options.Dedupe
+	Grouped      []LineRange // lines of each method chunk folded into this one by Options.GroupMethods
+	StartByte    int         // source byte range, set only for pieces of a split long line
+	EndByte      int
 	HasMore      bool
 	TotalChunks  int
 	CurrentChunk int
+	FileIndex    int    // position of the chunk's file among those passed to MergeChunks
+	FilePath     string // path of the file the chunk came from, as passed to NewChunker
+	NextName     string // Name, or failing that Signature, of the chunk after this one; set by Options.PeekNext
+	PrevName     string // Name of the chunk before this one
+}
+
+// LineRange is a span of 1-based source lines, both ends included.
+type LineRange struct {
+	Start, End int
 }
 
 type Chunker struct {
 	parser      *parser.Parser
+	filePath    string
 	sourceCode  []byte
 	sourceLines []string
 	maxTokens   int
+	opts        Options
+
+	// window limits chunking to a range of 0-based lines; set by ChunkRange
+	window *lineWindow
+	// progressLine is the line count last passed to Options.OnProgress
+	progressLine int
+	// tree is the syntax tree of sourceCode kept between calls to Update, so
+	// it can be re-parsed incrementally; nil until the first Update
+	tree *sitter.Tree
+	// chunks is the result of the last Update, to compare the next one with
+	chunks []Chunk
+	// styleImports holds the module directives a SCSS or LESS file opens
+	// with, for Options.PrependStyleImports; set by chunkCSS
+	styleImports string
+	// comments holds the byte ranges of the file's comments, for
+	// Options.StripComments; set by chunkByLanguage
+	comments []byteRange
+	// goLayout holds a Go file's declarations, for Options.GroupMethods; set
+	// by chunkGo
+	goLayout *goLayout
+	// outlineOnly makes chunkAST record each chunk's lines without joining
+	// them into Content; set by ChunkOutline when outlineWalks allows it
+	outlineOnly bool
+}
+
+type lineWindow struct {
+	from, to int
+}
+
+// intersects reports whether the 0-based lines start..end overlap w.
+func (w *lineWindow) intersects(start, end int) bool {
+	return end >= w.from && start <= w.to
 }
 
+// DefaultMaxTokens is the per-chunk token budget used when Options.MaxTokens
+// is not set.
+const DefaultMaxTokens = 2000
+
+// DefaultCharsPerToken is the bytes-per-token ratio used to estimate token
+// counts when Options.CharsPerToken is not set.
+const DefaultCharsPerToken = 4.0
+
+// DefaultContextLength is the longest Chunk.Context when
+// Options.ContextLength is not set.
+const DefaultContextLength = 60
+
+// Options tunes how a file is chunked. The zero value behaves like NewChunker
+// with DefaultMaxTokens.
+type Options struct {
+	// MaxTokens is the estimated token budget per chunk.
+	MaxTokens int
+
+	// ReservedTokens is subtracted from MaxTokens, for callers that send each
+	// chunk with a fixed prompt. It must be less than MaxTokens.
+	ReservedTokens int
+
+	// CharsPerToken is the bytes per token assumed when estimating token
+	// counts; it defaults to DefaultCharsPerToken.
+	CharsPerToken float64
+
+	// MaxLines, when > 0, caps the lines per chunk; longer chunks are split
+	// into pieces named "<Name> (cont.)".
+	MaxLines int
+
+	// MaxBytes, when > 0, caps the bytes of a chunk's Content, not counting
+	// a line marker; longer chunks are split like MaxLines pieces.
+	MaxBytes int
+
+	// ContextLength is the longest Chunk.Context in characters; it defaults
+	// to DefaultContextLength.
+	ContextLength int
+
+	// SkipGenerated replaces lockfiles and generated code with a single
+	// empty "generated" chunk.
+	SkipGenerated bool
+
+	// MarkUnparsed keeps regions tree-sitter could not parse as line-based
+	// chunks and types the chunks covering them "unparsed".
+	MarkUnparsed bool
+
+	// Overview prepends an "overview" chunk listing the file's top-level
+	// declarations. It covers no source lines (EndLine 0).
+	Overview bool
+
+	// Dedent removes the leading whitespace common to a chunk's lines,
+	// keeping it in Chunk.Indent; see Chunk.Reindent.
+	Dedent bool
+
+	// OnProgress, if set, is called with the number of source lines
+	// processed so far as chunking works through the file.
+	OnProgress func(linesProcessed, totalLines int)
+
+	// Dedupe collapses chunks with identical content into the first of
+	// them, recording the lines of the others in Chunk.Duplicates.
+	Dedupe bool
+
+	// MaxChunks caps how many chunks a file is split into, raising the
+	// token budget as needed.
+	MaxChunks int
+
+	// MaxDepth, when > 0, limits how many levels of nested declarations get
+	// chunks of their own; 1 chunks only top-level declarations.
+	MaxDepth int
+
+	// LineMarkers prefixes each chunk's Content with a comment giving its
+	// position, e.g. "// chunk 3/12 lines 120-160"; see Chunk.Unmarked.
+	LineMarkers bool
+
+	// PrependStyleImports prefixes the chunks of a SCSS or LESS file with
+	// the module directives it opens with; see Chunk.Unmarked.
+	PrependStyleImports bool
+
+	// MarkdownSplitLevels, when > 0, is the deepest markdown heading level
+	// that starts a chunk of its own.
+	MarkdownSplitLevels int
+
+	// NameExtractor, if set, names the chunks of files parsed with
+	// tree-sitter; an empty result falls back to the built-in name.
+	NameExtractor func(node *sitter.Node, source []byte) string
+
+	// OneChunkPerSymbol gives every declaration a chunk of its own instead
+	// of packing small neighbouring declarations together.
+	OneChunkPerSymbol bool
+
+	// GroupMethods appends a Go type's methods to the type's chunk when both
+	// fit, recording their lines in Chunk.Grouped.
+	GroupMethods bool
+
+	// StripComments removes comments from the Content of chunks of files
+	// parsed with tree-sitter. Stripped chunks fail ValidateChunks' line
+	// count check.
+	StripComments bool
+
+	// SplitJSX splits an oversized React component at the JSX elements it
+	// returns, and other oversized declarations at those they contain,
+	// instead of by lines.
+	SplitJSX bool
+
+	// PeekNext records the Name of the chunk after each one in
+	// Chunk.NextName.
+	PeekNext bool
+}
+
+// progressInterval is how many source lines pass between OnProgress calls.
+const progressInterval = 1000
+
 func NewChunker(filePath string, sourceCode []byte, maxTokens int) (*Chunker, error) {
-	p, err := parser.NewParser(filePath)
+	return NewChunkerWithOptions(filePath, sourceCode, Options{MaxTokens: maxTokens})
+}
+
+func NewChunkerWithOptions(filePath string, sourceCode []byte, opts Options) (*Chunker, error) {
+	p, err := parser.NewParserFor(filePath, sourceCode)
+	if err != nil {
+		return nil, newFileError(filePath, parser.DetectLanguage(filePath), err)
+	}
+	return NewChunkerWithParser(p, filePath, sourceCode, opts)
+}
+
+// NewChunkerWithParser builds a Chunker around an existing parser, such as
+// one from a parser.Pool. Chunkers sharing a parser must not chunk concurrently.
+func NewChunkerWithParser(p *parser.Parser, filePath string, sourceCode []byte, opts Options) (*Chunker, error) {
+	if grammar := parser.DetectGrammarFor(filePath, sourceCode); grammar != p.GetGrammar() {
+		return nil, fmt.Errorf("parser is for %s, but %s is %s", p.GetGrammar(), filePath, grammar)
+	}
+	if lang := parser.DetectLanguageFor(filePath, sourceCode); lang != p.GetLanguage() {
+		return nil, fmt.Errorf("parser is for %s, but %s is %s", p.GetLanguage(), filePath, lang)
+	}
+	return newChunker(p, filePath, sourceCode, opts)
+}
+
+// ChunkString chunks content in lang, a language name as
+// parser.DetectLanguage returns it such as "go" or "python", for code held
+// in memory with no file behind it, like a code block in a model's reply.
+// The chunks' FilePath is empty.
+func ChunkString(lang, content string, maxTokens int) ([]Chunk, error) {
+	p, err := parser.NewParserForLanguage(lang)
+	if err != nil {
+		return nil, newFileError("", lang, err)
+	}
+	c, err := newChunker(p, "", []byte(content), Options{MaxTokens: maxTokens})
 	if err != nil {
 		return nil, err
 	}
+	return c.ChunkFile()
+}
+
+// newChunker builds a Chunker around p, which must suit sourceCode, applying
+// the defaults for unset options.
+func newChunker(p *parser.Parser, filePath string, sourceCode []byte, opts Options) (*Chunker, error) {
+	if opts.MaxTokens <= 0 {
+		opts.MaxTokens = DefaultMaxTokens
+	}
+	if opts.CharsPerToken <= 0 {
+		opts.CharsPerToken = DefaultCharsPerToken
+	}
+	if opts.ContextLength <= 0 {
+		opts.ContextLength = DefaultContextLength
+	}
+	maxTokens := opts.MaxTokens
+	if opts.ReservedTokens > 0 {
+		if opts.ReservedTokens >= maxTokens {
+			return nil, newFileError(filePath, p.GetLanguage(), fmt.Errorf("%w: ReservedTokens %d leaves nothing of MaxTokens %d",
+				ErrInvalidOptions, opts.ReservedTokens, maxTokens))
+		}
+		maxTokens -= opts.ReservedTokens
+	}
 
 	lines := strings.Split(string(sourceCode), "\n")
 
 	return &Chunker{
 		parser:      p,
+		filePath:    filePath,
 		sourceCode:  sourceCode,
 		sourceLines: lines,
 		maxTokens:   maxTokens,
+		opts:        opts,
 	}, nil
 }
 
 func (c *Chunker) ChunkFile() ([]Chunk, error) {
+	// Empty input has nothing to chunk; whitespace-only input gets one chunk
+	// so callers still see the file, without any language chunker running.
+	if len(c.sourceCode) == 0 {
+		return []Chunk{}, nil
+	}
+	if looksBinary(c.sourceCode) {
+		return nil, newFileError(c.filePath, c.parser.GetLanguage(), ErrBinaryFile)
+	}
+	if len(bytes.TrimSpace(c.sourceCode)) == 0 {
+		return c.chunkBlank(), nil
+	}
+
+	// Progress runs over the whole call, so the retries fitChunkCount makes
+	// don't report lines already reported again
+	c.progressLine = 0
+	chunks, err := c.chunk()
+	if err == nil && c.opts.MaxChunks > 0 && len(chunks) > c.opts.MaxChunks {
+		chunks, err = c.fitChunkCount()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if c.opts.OnProgress != nil {
+		c.opts.OnProgress(c.LineCount(), c.LineCount())
+	}
+	return chunks, nil
+}
+
+// chunk runs the language chunker and the post-processing passes at the
+// current token budget.
+func (c *Chunker) chunk() ([]Chunk, error) {
+	c.comments, c.goLayout = nil, nil
+	chunks, err := c.chunkByLanguage()
+	if err != nil {
+		return nil, err
+	}
+	if c.window != nil {
+		kept := chunks[:0]
+		for _, ch := range chunks {
+			if c.window.intersects(ch.StartLine-1, ch.EndLine-1) {
+				kept = append(kept, ch)
+			}
+		}
+		chunks = kept
+	}
+
+	chunks = c.splitLongLines(chunks)
+	if c.opts.MaxLines > 0 {
+		chunks = c.capChunkLines(chunks)
+	}
+	if c.opts.MaxBytes > 0 {
+		chunks = c.capChunkBytes(chunks)
+	}
+	// Comments are stripped once chunks are cut at source lines and bytes
+	if len(c.comments) > 0 {
+		c.stripComments(chunks)
+	}
+	if c.goLayout != nil {
+		chunks = c.groupMethods(chunks)
+	}
+	// Descent into oversized nodes can emit a container's closing lines
+	// after its members; numbering and FindChunkByLine need line order.
+	// Pieces of one long line share a StartLine and keep their byte order.
+	sort.SliceStable(chunks, func(i, j int) bool { return chunks[i].StartLine < chunks[j].StartLine })
+	c.finalizeChunks(chunks)
+	if c.opts.Dedupe {
+		chunks = dedupeChunks(chunks)
+		linkNames(chunks, c.opts.PeekNext)
+	}
+	if c.opts.PrependStyleImports && c.styleImports != "" {
+		c.prependStyleImports(chunks)
+	}
+	if c.opts.LineMarkers {
+		c.addLineMarkers(chunks)
+	}
+	return chunks, nil
+}
+
+// fitChunkCount binary-searches for the smallest token budget above
+// MaxTokens at which the file splits into at most MaxChunks chunks, and
+// returns the chunks at that budget. Budgets beyond the whole file's size
+// can't merge chunks any further, so if even that budget yields too many
+// chunks (say, markdown with more headings than MaxChunks) its chunks are
+// returned as the closest fit.
+func (c *Chunker) fitChunkCount() ([]Chunk, error) {
+	defer func(maxTokens int) { c.maxTokens = maxTokens }(c.maxTokens)
+
+	lo, hi := c.maxTokens+1, c.estimateTokens(string(c.sourceCode))+1
+	if hi < lo {
+		hi = lo
+	}
+	top := hi
+	var best []Chunk
+	for lo <= hi {
+		c.maxTokens = lo + (hi-lo)/2
+		chunks, err := c.chunk()
+		if err != nil {
+			return nil, err
+		}
+		if len(chunks) <= c.opts.MaxChunks {
+			best = chunks
+			hi = c.maxTokens - 1
+		} else {
+			lo = c.maxTokens + 1
+		}
+	}
+	if best == nil {
+		c.maxTokens = top
+		return c.chunk()
+	}
+	return best, nil
+}
+
+// reportProgress passes the number of lines processed to Options.OnProgress
+// once at least progressInterval lines have passed since the last call.
+func (c *Chunker) reportProgress(lines int) {
+	if c.opts.OnProgress == nil || lines-c.progressLine < progressInterval {
+		return
+	}
+	c.progressLine = lines
+	c.opts.OnProgress(lines, c.LineCount())
+}
+
+// ChunkRange chunks only the declarations intersecting the 1-based lines
+// startLine..endLine; chunks may extend past either end of the range.
+func (c *Chunker) ChunkRange(startLine, endLine int) ([]Chunk, error) {
+	if startLine < 1 || endLine < startLine || startLine > c.LineCount() {
+		return nil, newFileError(c.filePath, c.parser.GetLanguage(),
+			fmt.Errorf("%w: %d-%d", ErrInvalidRange, startLine, endLine))
+	}
+	endLine = min(endLine, c.LineCount())
+
+	c.window = &lineWindow{from: startLine - 1, to: endLine - 1}
+	defer func() { c.window = nil }()
+	return c.ChunkFile()
+}
+
+// chunkByLanguage dispatches to the chunker for the detected language.
+func (c *Chunker) chunkByLanguage() ([]Chunk, error) {
 	lang := c.parser.GetLanguage()
 
+	if c.opts.SkipGenerated {
+		if reason := detectGenerated(c.filePath, c.sourceLines); reason != "" {
+			return c.chunkGenerated(reason), nil
+		}
+	}
+
 	// Non-AST languages: handle without tree-sitter
 	switch lang {
 	case "markdown":
 		return c.chunkMarkdown()
+	case "vue":
+		return c.chunkVue()
+	case "svelte":
+		return c.chunkSvelte()
+	case "dockerfile":
+		return c.chunkDockerfile()
+	case "graphql":
+		return c.chunkGraphQL()
+	case "zig":
+		return c.chunkZig()
+	case "dart":
+		return c.chunkDart()
+	case "haskell":
+		return c.chunkHaskell()
+	case "objc":
+		return c.chunkObjC()
+	case "perl":
+		return c.chunkPerl()
+	case "r":
+		return c.chunkR()
+	case "julia":
+		return c.chunkJulia()
+	case "wat":
+		return c.chunkWAT()
+	case "ini", "env", "properties":
+		return c.chunkINI()
 	case "text":
+		if isProse(c.filePath) {
+			return c.chunkProse()
+		}
 		return c.chunkFallback()
 	}
 
 	// AST-based languages
-	tree, err := c.parser.Parse(c.sourceCode)
-	if err != nil {
-		return nil, fmt.Errorf("failed to parse file: %w", err)
+	tree := c.tree
+	if tree == nil {
+		var err error
+		if tree, err = c.parser.Parse(c.sourceCode); err != nil {
+			return nil, newFileError(c.filePath, lang, err)
+		}
+		defer tree.Close()
 	}
-	defer tree.Close()
 
+	if c.opts.StripComments {
+		c.comments = commentRanges(tree.RootNode(), nil)
+	}
+	return c.chunkTree(lang, tree)
+}
+
+// chunkTree dispatches to the chunker for lang, a language with a
+// tree-sitter grammar, given the file's syntax tree.
+func (c *Chunker) chunkTree(lang string, tree *sitter.Tree) ([]Chunk, error) {
 	switch lang {
 	case "typescript":
 		return c.chunkTypeScript(tree)
@@ -71,238 +493,474 @@ func (c *Chunker) ChunkFile() ([]Chunk, error) {
 		return c.chunkPython(tree)
 	case "go":
 		return c.chunkGo(tree)
+	case "bash":
+		return c.chunkBash(tree)
+	case "json":
+		return c.chunkJSON(tree)
+	case "yaml":
+		return c.chunkYAML(tree)
+	case "ruby":
+		return c.chunkRuby(tree)
+	case "php":
+		return c.chunkPHP(tree)
+	case "kotlin":
+		return c.chunkKotlin(tree)
+	case "scala":
+		return c.chunkScala(tree)
+	case "swift":
+		return c.chunkSwift(tree)
+	case "html":
+		return c.chunkHTML(tree)
+	case "css", "scss", "less":
+		return c.chunkCSS(tree)
+	case "sql":
+		return c.chunkSQL(tree)
+	case "csharp":
+		return c.chunkCSharp(tree)
+	case "elixir":
+		return c.chunkElixir(tree)
+	case "lua":
+		return c.chunkLua(tree)
+	case "proto":
+		return c.chunkProto(tree)
+	case "hcl":
+		return c.chunkHCL(tree)
 	default:
 		return c.chunkFallback()
 	}
 }
 
-func (c *Chunker) chunkTypeScript(tree *sitter.Tree) ([]Chunk, error) {
-	root := tree.RootNode()
-	var chunks []Chunk
-	var currentChunk []string
-	var currentStartLine int
-	currentTokens := 0
+// astSpec describes how a tree-sitter grammar maps onto chunks: which node
+// types start a chunk, how their types are labelled, and what to do with a
+// single node that is larger than the token budget.
+type astSpec struct {
+	targets  map[string]bool
+	typeName func(nodeType string) string
+	// nodeName overrides extractNodeName for grammars whose names aren't a
+	// direct identifier child.
+	nodeName func(node *sitter.Node, source string) string
+	// kindName refines typeName for grammars that share one node type
+	// between several kinds of declaration; "" falls back to typeName.
+	kindName func(node *sitter.Node, source string) string
+	// parentName names the type a node belongs to when that isn't given by
+	// nesting, such as a Go method's receiver.
+	parentName func(node *sitter.Node, source string) string
+	// match narrows targets for grammars that share one node type between
+	// declarations and other code, such as Elixir's `def` calls.
+	match func(node *sitter.Node, source string) bool
+	// calls maps call node types to their callee field for Chunk.References;
+	// nil leaves References empty.
+	calls map[string]string
+	// isolate lists target types that never share a chunk with other nodes.
+	isolate map[string]bool
+	// descend makes oversized nodes recurse into their children instead of
+	// being split into line ranges.
+	descend bool
+}
 
-	targetNodeTypes := map[string]bool{
-		"class_declaration":       true,
-		"function_declaration":    true,
-		"method_definition":       true,
-		"interface_declaration":   true,
-		"type_alias_declaration":  true,
-		"export_statement":        true,
-		"lexical_declaration":     true,
+// isTarget reports whether n starts a chunk. Only named nodes count, since
+// some grammars give keyword tokens the same type as the construct (Ruby's
+// `class` keyword inside a `class` node).
+func (spec astSpec) isTarget(n *sitter.Node, source string) bool {
+	if !n.IsNamed() || !spec.targets[n.Type()] {
+		return false
 	}
+	return spec.match == nil || spec.match(n, source)
+}
 
-	var walkNodes func(node *sitter.Node)
-	walkNodes = func(node *sitter.Node) {
-		nodeType := node.Type()
+var typeScriptSpec = astSpec{
+	targets: map[string]bool{
+		"class_declaration":      true,
+		"function_declaration":   true,
+		"method_definition":      true,
+		"interface_declaration":  true,
+		"type_alias_declaration": true,
+		"export_statement":       true,
+		"lexical_declaration":    true,
+		"function_signature":     true,
+	},
+	typeName: extractNodeType,
+	calls:    callExpressionCalls,
+}
 
-		if targetNodeTypes[nodeType] || node == root {
-			startLine := int(node.StartPoint().Row)
-			endLine := int(node.EndPoint().Row)
+var javaScriptSpec = astSpec{
+	targets: map[string]bool{
+		"class_declaration":    true,
+		"function_declaration": true,
+		"method_definition":    true,
+		"lexical_declaration":  true,
+		"variable_declaration": true,
+		"export_statement":     true,
+	},
+	typeName: extractNodeType,
+	calls:    callExpressionCalls,
+}
 
-			nodeContent := c.getLinesRange(startLine, endLine)
-			nodeTokens := estimateTokens(nodeContent)
+var pythonSpec = astSpec{
+	targets: map[string]bool{
+		"class_definition":     true,
+		"function_definition":  true,
+		"decorated_definition": true,
+	},
+	typeName: extractPythonNodeType,
+	calls:    pythonCalls,
+	descend:  true,
+}
 
-			// Handle oversized single nodes - split into manageable chunks
-			if nodeTokens > c.maxTokens {
-				// Calculate how many lines to include per chunk
-				// Average ~50 chars per line, 4 chars per token = ~12-13 lines per 1000 tokens
-				avgCharsPerLine := len(nodeContent) / (endLine - startLine + 1)
-				if avgCharsPerLine == 0 {
-					avgCharsPerLine = 50 // default estimate
-				}
-				charsPerChunk := c.maxTokens * 4
-				linesPerChunk := charsPerChunk / avgCharsPerLine
-				if linesPerChunk < 10 {
-					linesPerChunk = 10 // minimum chunk size
-				}
+var goSpec = astSpec{
+	targets: map[string]bool{
+		"function_declaration": true,
+		"method_declaration":   true,
+		"type_declaration":     true,
+		"const_declaration":    true,
+		"var_declaration":      true,
+	},
+	typeName:   extractGoNodeType,
+	nodeName:   extractGoNodeName,
+	parentName: extractGoReceiverType,
+	calls:      callExpressionCalls,
+	descend:    true,
+}
 
-				numLines := endLine - startLine + 1
+func (c *Chunker) chunkTypeScript(tree *sitter.Tree) ([]Chunk, error) {
+	if c.opts.SplitJSX {
+		return c.chunkAST(tree, withJSX(typeScriptSpec))
+	}
+	return c.chunkAST(tree, typeScriptSpec)
+}
 
-				for offset := 0; offset < numLines; offset += linesPerChunk {
-					chunkStart := startLine + offset
-					chunkEnd := chunkStart + linesPerChunk - 1
-					if chunkEnd > endLine {
-						chunkEnd = endLine
-					}
+func (c *Chunker) chunkJavaScript(tree *sitter.Tree) ([]Chunk, error) {
+	if c.opts.SplitJSX {
+		return c.chunkAST(tree, withJSX(javaScriptSpec))
+	}
+	return c.chunkAST(tree, javaScriptSpec)
+}
 
-					chunkLines := []string{}
-					for i := chunkStart; i <= chunkEnd && i < len(c.sourceLines); i++ {
-						chunkLines = append(chunkLines, c.sourceLines[i])
-					}
+func (c *Chunker) chunkPython(tree *sitter.Tree) ([]Chunk, error) {
+	return c.chunkAST(tree, pythonSpec)
+}
 
-					if len(chunkLines) > 0 {
-						chunkContent := strings.Join(chunkLines, "\n")
-						chunkName := extractNamesFromContent(chunkContent)
-						if chunkName == "" {
-							chunkName = extractNodeName(node, string(c.sourceCode))
-						}
-						chunks = append(chunks, Chunk{
-							Content:   chunkContent,
-							StartLine: chunkStart + 1,
-							EndLine:   chunkEnd + 1,
-							Type:      extractNodeType(nodeType),
-							Name:      chunkName,
-						})
-					}
-				}
-				return
-			}
+func (c *Chunker) chunkGo(tree *sitter.Tree) ([]Chunk, error) {
+	if c.opts.GroupMethods {
+		c.goLayout = c.goDeclarations(tree.RootNode())
+	}
+	return c.chunkAST(tree, goSpec)
+}
 
-			if currentTokens+nodeTokens > c.maxTokens && len(currentChunk) > 0 {
-				chunkContent := strings.Join(currentChunk, "\n")
-				chunks = append(chunks, Chunk{
-					Content:   chunkContent,
-					StartLine: currentStartLine + 1,
-					EndLine:   currentStartLine + len(currentChunk),
-					Type:      extractNodeType(nodeType),
-					Name:      extractNodeName(node, string(c.sourceCode)),
-				})
-				currentChunk = []string{}
-				currentStartLine = startLine
-				currentTokens = 0
-			}
+// chunkAST walks the syntax tree and packs target nodes into chunks of at
+// most maxTokens. Each chunk takes its Type, Name and Signature from the
+// first node it contains.
+func (c *Chunker) chunkAST(tree *sitter.Tree, spec astSpec) ([]Chunk, error) {
+	root := tree.RootNode()
+	source := string(c.sourceCode)
+	var chunks []Chunk
+	// Line ranges queued for the next chunk, joined only when it is flushed
+	var currentRanges []lineWindow
+	var currentLines int
+	var currentStartLine int
+	var currentLead *sitter.Node
+	var currentParent string
+	var currentDepth int
+	var currentUnparsed bool
+	currentTokens := 0
+	// The trailing run of consecutive nodes sharing a name and type in the
+	// current chunk, such as a function's overloads or clauses, which moves
+	// to the next chunk as a whole rather than being split across two
+	var run struct {
+		name, kind     string
+		from           int // index of its first range in currentRanges
+		lead           *sitter.Node
+		tokens, lines  int
+		unparsed       bool // a node in the run has a syntax error
+		unparsedBefore bool // a node before the run has a syntax error
+	}
+	// Names of the oversized targets being descended through, innermost last
+	var parents []string
+	lastLine := -1 // last source line emitted or queued
+	// Pieces of an oversized node are named from the TS/JS declarations
+	// they hold
+	lang := c.parser.GetLanguage()
+	tsLike := lang == "typescript" || lang == "javascript"
 
-			if len(currentChunk) == 0 {
-				currentStartLine = startLine
+	nodeName := spec.nodeName
+	if nodeName == nil {
+		nodeName = extractNodeName
+	}
+	if custom := c.opts.NameExtractor; custom != nil {
+		builtin := nodeName
+		nodeName = func(node *sitter.Node, source string) string {
+			if name := custom(node, c.sourceCode); name != "" {
+				return name
 			}
+			return builtin(node, source)
+		}
+	}
 
-			for i := startLine; i <= endLine && i < len(c.sourceLines); i++ {
-				currentChunk = append(currentChunk, c.sourceLines[i])
+	typeName := func(node *sitter.Node) string {
+		if spec.kindName != nil {
+			if kind := spec.kindName(node, source); kind != "" {
+				return kind
 			}
-			currentTokens += nodeTokens
-
-			return
 		}
+		return spec.typeName(node.Type())
+	}
 
-		for i := 0; i < int(node.ChildCount()); i++ {
-			child := node.Child(i)
-			if child != nil {
-				walkNodes(child)
+	parentOf := func(node *sitter.Node) string {
+		if spec.parentName != nil {
+			if parent := spec.parentName(node, source); parent != "" {
+				return parent
 			}
 		}
+		for i := len(parents) - 1; i >= 0; i-- {
+			if parents[i] != "" {
+				return parents[i]
+			}
+		}
+		return ""
 	}
 
-	walkNodes(root)
-
-	if len(currentChunk) > 0 {
-		chunkContent := strings.Join(currentChunk, "\n")
-		chunks = append(chunks, Chunk{
-			Content:   chunkContent,
-			StartLine: currentStartLine + 1,
-			EndLine:   currentStartLine + len(currentChunk),
-			Type:      "code",
-			Name:      "",
-		})
+	newChunk := func(content string, startLine, endLine int, node *sitter.Node, parent string, depth int) Chunk {
+		return Chunk{
+			Content:    content,
+			StartLine:  startLine,
+			EndLine:    endLine,
+			Type:       typeName(node),
+			NodeType:   node.Type(),
+			Name:       nodeName(node, source),
+			Signature:  c.extractSignature(node, root),
+			Parent:     parent,
+			Parameters: extractParameters(node, root, source),
+			ReturnType: extractReturnType(node, root, source),
+			Depth:      depth,
+		}
 	}
 
-	for i := range chunks {
-		chunks[i].TotalChunks = len(chunks)
-		chunks[i].CurrentChunk = i
-		chunks[i].HasMore = i < len(chunks)-1
-		chunks[i].Context = extractContext(chunks[i].Content)
+	flush := func() {
+		if len(currentRanges) == 0 {
+			return
+		}
+		var content strings.Builder
+		for i, r := range currentRanges {
+			if c.outlineOnly {
+				// An outline needs only the lines, not their text
+				break
+			}
+			if i > 0 {
+				content.WriteByte('\n')
+			}
+			content.WriteString(c.linesToString(r.from, r.to))
+		}
+		chunk := newChunk(
+			content.String(),
+			currentStartLine+1,
+			currentStartLine+currentLines,
+			currentLead,
+			currentParent,
+			currentDepth,
+		)
+		if currentUnparsed {
+			chunk.Type = "unparsed"
+		}
+		chunks = append(chunks, chunk)
+		currentRanges = currentRanges[:0]
+		currentLines = 0
+		run.name = ""
+		currentLead = nil
+		currentParent = ""
+		currentUnparsed = false
+		currentTokens = 0
 	}
 
-	return chunks, nil
-}
-
-func (c *Chunker) chunkJavaScript(tree *sitter.Tree) ([]Chunk, error) {
-	root := tree.RootNode()
-	var chunks []Chunk
-	var currentChunk []string
-	var currentStartLine int
-	currentTokens := 0
-
-	targetNodeTypes := map[string]bool{
-		"class_declaration":      true,
-		"function_declaration":   true,
-		"method_definition":      true,
-		"lexical_declaration":    true,
-		"variable_declaration":   true,
-		"export_statement":       true,
+	// extendTo adds the lines after lastLine through end to the chunk being
+	// built, or to the last chunk if none is
+	extendTo := func(end int) {
+		if len(currentRanges) > 0 {
+			currentRanges = append(currentRanges, lineWindow{from: lastLine + 1, to: end})
+			currentLines += end - lastLine
+		} else if n := len(chunks); n > 0 {
+			if !c.outlineOnly {
+				chunks[n-1].Content += "\n" + c.linesToString(lastLine+1, end)
+			}
+			chunks[n-1].EndLine = end + 1
+		}
+		lastLine = end
 	}
 
 	var walkNodes func(node *sitter.Node)
 	walkNodes = func(node *sitter.Node) {
-		nodeType := node.Type()
+		// Declarations outside the requested range are never read
+		if c.window != nil && node != root {
+			if startLine, endLine := c.nodeLines(node); !c.window.intersects(startLine, endLine) {
+				// ... nor are the lines leading up to them
+				lastLine = max(lastLine, endLine)
+				return
+			}
+		}
 
-		if targetNodeTypes[nodeType] || node == root {
-			startLine := int(node.StartPoint().Row)
-			endLine := int(node.EndPoint().Row)
+		// Text tree-sitter couldn't parse is kept by lines
+		if c.opts.MarkUnparsed && node.IsError() {
+			_, endLine := c.nodeLines(node)
+			if endLine > lastLine {
+				startLine := lastLine + 1
+				flush()
+				chunks = append(chunks, c.splitLineRange(startLine, endLine, "unparsed", "")...)
+				lastLine = endLine
+			}
+			return
+		}
 
-			nodeContent := c.getLinesRange(startLine, endLine)
-			nodeTokens := estimateTokens(nodeContent)
+		if spec.isTarget(node, source) || node == root {
+			startLine, endLine := c.nodeLines(node)
 
-			// Handle oversized single nodes - split into manageable chunks
-			if nodeTokens > c.maxTokens {
-				// Calculate how many lines to include per chunk
-				// Average ~50 chars per line, 4 chars per token = ~12-13 lines per 1000 tokens
-				avgCharsPerLine := len(nodeContent) / (endLine - startLine + 1)
-				if avgCharsPerLine == 0 {
-					avgCharsPerLine = 50 // default estimate
+			// Siblings sharing a line (e.g. `"a": 1, "b": 2` or minified
+			// CSS) are already covered by the chunk that took the line
+			if endLine <= lastLine {
+				return
+			}
+			if startLine <= lastLine {
+				startLine = lastLine + 1
+			}
+
+			nodeLen := c.linesLen(startLine, endLine)
+			nodeTokens := c.tokensForLen(nodeLen)
+
+			// Handle oversized single nodes by descending to the targets they
+			// contain; the file itself always descends to its declarations
+			canDescend := node == root || (spec.descend && (c.opts.MaxDepth <= 0 || len(parents)+1 < c.opts.MaxDepth))
+			// With OneChunkPerSymbol even a small file is split at its declarations
+			oversized := nodeTokens > c.maxTokens || node == root && c.opts.OneChunkPerSymbol
+			if oversized && canDescend && hasTargetDescendant(node, spec, source) {
+				if node != root {
+					// A container's members don't share chunks with what
+					// surrounds it
+					flush()
+					parents = append(parents, nodeName(node, source))
+				} else if end := c.headerEnd(root, spec, source); end >= 0 && (c.window == nil || c.window.intersects(0, end)) {
+					// The license and file comment are a chunk of their own
+					chunks = append(chunks, c.splitLineRange(0, end, "header", "")...)
+					lastLine = end
 				}
-				charsPerChunk := c.maxTokens * 4
-				linesPerChunk := charsPerChunk / avgCharsPerLine
-				if linesPerChunk < 10 {
-					linesPerChunk = 10 // minimum chunk size
+				for i := 0; i < int(node.ChildCount()); i++ {
+					child := node.Child(i)
+					if child != nil {
+						walkNodes(child)
+					}
+				}
+				if node != root {
+					// ... and its closing lines end its last member's chunk
+					if endLine > lastLine && (c.window == nil || c.window.intersects(lastLine+1, endLine)) {
+						extendTo(endLine)
+					}
+					flush()
+					parents = parents[:len(parents)-1]
 				}
+				return
+			}
 
-				numLines := endLine - startLine + 1
+			// The lines since the previous node, such as blank lines and
+			// comments, go with this one so every line lands in a chunk
+			if startLine > lastLine+1 {
+				startLine = lastLine + 1
+				nodeLen = c.linesLen(startLine, endLine)
+				nodeTokens = c.tokensForLen(nodeLen)
+			}
 
-				for offset := 0; offset < numLines; offset += linesPerChunk {
-					chunkStart := startLine + offset
-					chunkEnd := chunkStart + linesPerChunk - 1
-					if chunkEnd > endLine {
-						chunkEnd = endLine
+			// Remaining oversized nodes are split into manageable chunks, each
+			// taking lines while their running token estimate fits the budget
+			if nodeTokens > c.maxTokens {
+				flush()
+
+				last := min(endLine, c.LineCount()-1)
+				for chunkStart := startLine; chunkStart <= last; {
+					chunkEnd, size := chunkStart, len(c.sourceLines[chunkStart])
+					for chunkEnd < last && c.tokensForLen(size+1+len(c.sourceLines[chunkEnd+1])) <= c.maxTokens {
+						chunkEnd++
+						size += 1 + len(c.sourceLines[chunkEnd])
 					}
 
-					chunkLines := []string{}
-					for i := chunkStart; i <= chunkEnd && i < len(c.sourceLines); i++ {
-						chunkLines = append(chunkLines, c.sourceLines[i])
+					var chunkContent string
+					if !c.outlineOnly || tsLike {
+						chunkContent = c.linesToString(chunkStart, chunkEnd)
 					}
-
-					if len(chunkLines) > 0 {
-						chunkContent := strings.Join(chunkLines, "\n")
-						chunkName := extractNamesFromContent(chunkContent)
-						if chunkName == "" {
-							chunkName = extractNodeName(node, string(c.sourceCode))
+					chunk := newChunk(chunkContent, chunkStart+1, chunkEnd+1, node, parentOf(node), len(parents))
+					if c.outlineOnly {
+						chunk.Content = ""
+					}
+					if tsLike {
+						if name := extractNamesFromContent(chunkContent); name != "" {
+							chunk.Name = name
 						}
-						chunks = append(chunks, Chunk{
-							Content:   chunkContent,
-							StartLine: chunkStart + 1,
-							EndLine:   chunkEnd + 1,
-							Type:      extractNodeType(nodeType),
-							Name:      chunkName,
-						})
 					}
+					if c.opts.MarkUnparsed && hasErrorInRows(node, chunkStart, chunkEnd) {
+						chunk.Type = "unparsed"
+					}
+					chunks = append(chunks, chunk)
+					chunkStart = chunkEnd + 1
 				}
+				lastLine = endLine
+				c.reportProgress(lastLine + 1)
 				return
 			}
 
-			if currentTokens+nodeTokens > c.maxTokens && len(currentChunk) > 0 {
-				chunkContent := strings.Join(currentChunk, "\n")
-				chunks = append(chunks, Chunk{
-					Content:   chunkContent,
-					StartLine: currentStartLine + 1,
-					EndLine:   currentStartLine + len(currentChunk),
-					Type:      extractNodeType(nodeType),
-					Name:      extractNodeName(node, string(c.sourceCode)),
-				})
-				currentChunk = []string{}
-				currentStartLine = startLine
-				currentTokens = 0
+			name, kind := nodeName(node, source), typeName(node)
+			sameName := name != "" && name == run.name && kind == run.kind
+			switch {
+			case spec.isolate[node.Type()] || c.opts.OneChunkPerSymbol:
+				flush()
+				sameName = false
+			case currentTokens+nodeTokens <= c.maxTokens:
+			case sameName && run.from > 0 && run.tokens+nodeTokens <= c.maxTokens:
+				// Flush the nodes before the run and carry the run over
+				carried := append([]lineWindow(nil), currentRanges[run.from:]...)
+				carry := run
+				currentRanges = currentRanges[:run.from]
+				currentLines -= run.lines
+				currentUnparsed = run.unparsedBefore
+				flush()
+				currentRanges = append(currentRanges, carried...)
+				currentStartLine = carried[0].from
+				currentLead = carry.lead
+				currentParent = parentOf(carry.lead)
+				currentDepth = len(parents)
+				currentLines = carry.lines
+				currentTokens = carry.tokens
+				currentUnparsed = carry.unparsed
+				run = carry
+				run.from, run.unparsedBefore = 0, false
+			default:
+				flush()
+				sameName = false
 			}
 
-			if len(currentChunk) == 0 {
+			if len(currentRanges) == 0 {
 				currentStartLine = startLine
+				currentLead = node
+				currentParent = parentOf(node)
+				currentDepth = len(parents)
+			}
+			if !sameName {
+				run.name, run.kind, run.from, run.lead = name, kind, len(currentRanges), node
+				run.tokens, run.lines = 0, 0
+				run.unparsed, run.unparsedBefore = false, currentUnparsed
 			}
 
-			for i := startLine; i <= endLine && i < len(c.sourceLines); i++ {
-				currentChunk = append(currentChunk, c.sourceLines[i])
+			if last := min(endLine, c.LineCount()-1); startLine <= last {
+				currentRanges = append(currentRanges, lineWindow{from: startLine, to: last})
+				currentLines += last - startLine + 1
+				run.lines += last - startLine + 1
 			}
 			currentTokens += nodeTokens
+			run.tokens += nodeTokens
+			lastLine = endLine
+			c.reportProgress(lastLine + 1)
+			if c.opts.MarkUnparsed && node.HasError() {
+				currentUnparsed = true
+				run.unparsed = true
+			}
 
+			if spec.isolate[node.Type()] || c.opts.OneChunkPerSymbol {
+				flush()
+			}
 			return
 		}
 
@@ -316,284 +974,194 @@ func (c *Chunker) chunkJavaScript(tree *sitter.Tree) ([]Chunk, error) {
 
 	walkNodes(root)
 
-	if len(currentChunk) > 0 {
-		chunkContent := strings.Join(currentChunk, "\n")
-		chunks = append(chunks, Chunk{
-			Content:   chunkContent,
-			StartLine: currentStartLine + 1,
-			EndLine:   currentStartLine + len(currentChunk),
-			Type:      "code",
-			Name:      "",
-		})
+	// Lines after the last node, such as a closing brace or a trailing
+	// comment, end the last chunk
+	if tail := c.LineCount() - 1; tail > lastLine && (c.window == nil || c.window.intersects(lastLine+1, tail)) {
+		extendTo(tail)
 	}
+	flush()
 
 	for i := range chunks {
-		chunks[i].TotalChunks = len(chunks)
-		chunks[i].CurrentChunk = i
-		chunks[i].HasMore = i < len(chunks)-1
-		chunks[i].Context = extractContext(chunks[i].Content)
-	}
-
-	return chunks, nil
-}
-
-func (c *Chunker) chunkPython(tree *sitter.Tree) ([]Chunk, error) {
-	root := tree.RootNode()
-	var chunks []Chunk
-	var currentChunk []string
-	var currentStartLine int
-	currentTokens := 0
-
-	targetNodeTypes := map[string]bool{
-		"class_definition":      true,
-		"function_definition":   true,
-		"decorated_definition":  true,
+		chunks[i].Context = c.extractContext(chunks[i].Content)
+		chunks[i].References = extractReferences(root, chunks[i].StartLine-1, chunks[i].EndLine-1, source, spec.calls)
 	}
 
-	var walkNodes func(node *sitter.Node)
-	walkNodes = func(node *sitter.Node) {
-		nodeType := node.Type()
-
-		if targetNodeTypes[nodeType] || node == root {
-			startLine := int(node.StartPoint().Row)
-			endLine := int(node.EndPoint().Row)
-
-			nodeContent := c.getLinesRange(startLine, endLine)
-			nodeTokens := estimateTokens(nodeContent)
-
-			// Handle oversized single nodes
-			if nodeTokens > c.maxTokens {
-				childCount := int(node.ChildCount())
-				if childCount > 0 {
-					for i := 0; i < childCount; i++ {
-						child := node.Child(i)
-						if child != nil {
-							walkNodes(child)
-						}
-					}
-					return
-				}
-
-				lineBudget := c.maxTokens * 4
-				for start := startLine; start <= endLine; start += lineBudget {
-					end := start + lineBudget
-					if end > endLine {
-						end = endLine
-					}
-
-					if len(currentChunk) > 0 {
-						chunkContent := strings.Join(currentChunk, "\n")
-						chunks = append(chunks, Chunk{
-							Content:   chunkContent,
-							StartLine: currentStartLine + 1,
-							EndLine:   currentStartLine + len(currentChunk),
-							Type:      extractPythonNodeType(nodeType),
-							Name:      extractNodeName(node, string(c.sourceCode)),
-						})
-						currentChunk = []string{}
-						currentTokens = 0
-					}
-
-					currentStartLine = start
-					for i := start; i <= end && i < len(c.sourceLines); i++ {
-						currentChunk = append(currentChunk, c.sourceLines[i])
-					}
-					currentTokens = estimateTokens(strings.Join(currentChunk, "\n"))
-				}
-				return
-			}
-
-			if currentTokens+nodeTokens > c.maxTokens && len(currentChunk) > 0 {
-				chunkContent := strings.Join(currentChunk, "\n")
-				chunks = append(chunks, Chunk{
-					Content:   chunkContent,
-					StartLine: currentStartLine + 1,
-					EndLine:   currentStartLine + len(currentChunk),
-					Type:      extractPythonNodeType(nodeType),
-					Name:      extractNodeName(node, string(c.sourceCode)),
-				})
-				currentChunk = []string{}
-				currentStartLine = startLine
-				currentTokens = 0
-			}
-
-			if len(currentChunk) == 0 {
-				currentStartLine = startLine
-			}
-
-			for i := startLine; i <= endLine && i < len(c.sourceLines); i++ {
-				currentChunk = append(currentChunk, c.sourceLines[i])
+	// Top-level declarations are the named targets not nested in another
+	// one; unnamed wrappers such as export statements are looked through.
+	var symbols []string
+	var collect func(node *sitter.Node)
+	collect = func(node *sitter.Node) {
+		for i := 0; i < int(node.NamedChildCount()); i++ {
+			child := node.NamedChild(i)
+			name := ""
+			if spec.isTarget(child, source) {
+				name = nodeName(child, source)
 			}
-			currentTokens += nodeTokens
-
-			return
-		}
-
-		for i := 0; i < int(node.ChildCount()); i++ {
-			child := node.Child(i)
-			if child != nil {
-				walkNodes(child)
+			if name != "" {
+				symbols = append(symbols, typeName(child)+" "+name)
+			} else {
+				collect(child)
 			}
 		}
 	}
-
-	walkNodes(root)
-
-	if len(currentChunk) > 0 {
-		chunkContent := strings.Join(currentChunk, "\n")
-		chunks = append(chunks, Chunk{
-			Content:   chunkContent,
-			StartLine: currentStartLine + 1,
-			EndLine:   currentStartLine + len(currentChunk),
-			Type:      "code",
-			Name:      "",
-		})
+	if c.opts.Overview {
+		collect(root)
 	}
 
-	for i := range chunks {
-		chunks[i].TotalChunks = len(chunks)
-		chunks[i].CurrentChunk = i
-		chunks[i].HasMore = i < len(chunks)-1
-		chunks[i].Context = extractContext(chunks[i].Content)
+	if len(symbols) > 0 {
+		overview := Chunk{
+			Content:   strings.Join(symbols, ", "),
+			StartLine: 1,
+			Type:      "overview",
+			Name:      filepath.Base(c.filePath),
+			Context:   fmt.Sprintf("%d top-level declarations", len(symbols)),
+		}
+		chunks = append([]Chunk{overview}, chunks...)
 	}
 
+	c.finalizeChunks(chunks)
 	return chunks, nil
 }
 
-func (c *Chunker) chunkGo(tree *sitter.Tree) ([]Chunk, error) {
-	root := tree.RootNode()
-	var chunks []Chunk
-	var currentChunk []string
-	var currentStartLine int
-	currentTokens := 0
+// ChunkSummary describes how a file would be chunked without carrying any
+// chunk content.
+type ChunkSummary struct {
+	Language    string
+	TotalChunks int
+	TotalTokens int
+	Types       map[string]int // chunk count per Type, e.g. "function": 12
+}
 
-	targetNodeTypes := map[string]bool{
-		"function_declaration": true,
-		"method_declaration":   true,
-		"type_declaration":     true,
-		"const_declaration":    true,
-		"var_declaration":      true,
+// Summarize chunks the file and reports only aggregate metadata. Like
+// ChunkOutline, it walks files parsed with tree-sitter without building
+// chunk content when no option needs it.
+func (c *Chunker) Summarize() (ChunkSummary, error) {
+	chunks, err := c.chunkOutline()
+	if err != nil {
+		return ChunkSummary{}, err
 	}
 
-	var walkNodes func(node *sitter.Node)
-	walkNodes = func(node *sitter.Node) {
-		nodeType := node.Type()
-
-		if targetNodeTypes[nodeType] || node == root {
-			startLine := int(node.StartPoint().Row)
-			endLine := int(node.EndPoint().Row)
-
-			nodeContent := c.getLinesRange(startLine, endLine)
-			nodeTokens := estimateTokens(nodeContent)
-
-			// Handle oversized single nodes
-			if nodeTokens > c.maxTokens {
-				childCount := int(node.ChildCount())
-				if childCount > 0 {
-					for i := 0; i < childCount; i++ {
-						child := node.Child(i)
-						if child != nil {
-							walkNodes(child)
-						}
-					}
-					return
-				}
-
-				lineBudget := c.maxTokens * 4
-				for start := startLine; start <= endLine; start += lineBudget {
-					end := start + lineBudget
-					if end > endLine {
-						end = endLine
-					}
-
-					if len(currentChunk) > 0 {
-						chunkContent := strings.Join(currentChunk, "\n")
-						chunks = append(chunks, Chunk{
-							Content:   chunkContent,
-							StartLine: currentStartLine + 1,
-							EndLine:   currentStartLine + len(currentChunk),
-							Type:      extractGoNodeType(nodeType),
-							Name:      extractNodeName(node, string(c.sourceCode)),
-						})
-						currentChunk = []string{}
-						currentTokens = 0
-					}
-
-					currentStartLine = start
-					for i := start; i <= end && i < len(c.sourceLines); i++ {
-						currentChunk = append(currentChunk, c.sourceLines[i])
-					}
-					currentTokens = estimateTokens(strings.Join(currentChunk, "\n"))
-				}
-				return
-			}
-
-			if currentTokens+nodeTokens > c.maxTokens && len(currentChunk) > 0 {
-				chunkContent := strings.Join(currentChunk, "\n")
-				chunks = append(chunks, Chunk{
-					Content:   chunkContent,
-					StartLine: currentStartLine + 1,
-					EndLine:   currentStartLine + len(currentChunk),
-					Type:      extractGoNodeType(nodeType),
-					Name:      extractNodeName(node, string(c.sourceCode)),
-				})
-				currentChunk = []string{}
-				currentStartLine = startLine
-				currentTokens = 0
-			}
+	summary := ChunkSummary{
+		Language:    c.parser.GetLanguage(),
+		TotalChunks: len(chunks),
+		Types:       make(map[string]int),
+	}
+	for i := range chunks {
+		summary.Types[chunks[i].Type]++
+	}
+	summary.TotalTokens = TotalTokens(chunks)
+	return summary, nil
+}
 
-			if len(currentChunk) == 0 {
-				currentStartLine = startLine
-			}
+// ChunkMeta is a chunk without its content: enough to render a navigable
+// outline of a file and to fetch a chunk later by its line range.
+type ChunkMeta struct {
+	StartLine  int
+	EndLine    int
+	Type       string
+	Name       string
+	Signature  string
+	Parent     string
+	Breadcrumb string
+	Depth      int
+	Tokens     int
+	Hash       string
+	StableID   string
+	IsTest     bool
+}
 
-			for i := startLine; i <= endLine && i < len(c.sourceLines); i++ {
-				currentChunk = append(currentChunk, c.sourceLines[i])
-			}
-			currentTokens += nodeTokens
+// Meta returns the chunk's metadata without its content.
+func (ch Chunk) Meta() ChunkMeta {
+	return ChunkMeta{
+		StartLine:  ch.StartLine,
+		EndLine:    ch.EndLine,
+		Type:       ch.Type,
+		Name:       ch.Name,
+		Signature:  ch.Signature,
+		Parent:     ch.Parent,
+		Breadcrumb: ch.Breadcrumb,
+		Depth:      ch.Depth,
+		Tokens:     ch.Tokens,
+		Hash:       ch.Hash,
+		StableID:   ch.StableID,
+		IsTest:     ch.IsTest,
+	}
+}
 
-			return
-		}
+// ChunkOutline chunks the file and returns each chunk's metadata in order,
+// for a table-of-contents view. Files parsed with tree-sitter are walked
+// without building chunk content unless an option such as Dedent or
+// StripComments needs it.
+func (c *Chunker) ChunkOutline() ([]ChunkMeta, error) {
+	chunks, err := c.chunkOutline()
+	if err != nil {
+		return nil, err
+	}
 
-		for i := 0; i < int(node.ChildCount()); i++ {
-			child := node.Child(i)
-			if child != nil {
-				walkNodes(child)
-			}
-		}
+	outline := make([]ChunkMeta, len(chunks))
+	for i := range chunks {
+		outline[i] = chunks[i].Meta()
 	}
+	return outline, nil
+}
 
-	walkNodes(root)
+// chunkOutline is ChunkFile for ChunkOutline and Summarize, which read only
+// chunk metadata: when outlineWalks allows, the chunks come back with empty
+// Content.
+func (c *Chunker) chunkOutline() ([]Chunk, error) {
+	if c.outlineWalks() {
+		c.outlineOnly = true
+		defer func() { c.outlineOnly = false }()
+	}
+	return c.ChunkFile()
+}
 
-	if len(currentChunk) > 0 {
-		chunkContent := strings.Join(currentChunk, "\n")
-		chunks = append(chunks, Chunk{
-			Content:   chunkContent,
-			StartLine: currentStartLine + 1,
-			EndLine:   currentStartLine + len(currentChunk),
-			Type:      "code",
-			Name:      "",
-		})
+// outlineWalks reports whether the file's chunk metadata can be had without
+// building chunk content: it is parsed with tree-sitter, no option reshapes
+// or rewrites content, and no line is long enough for splitLongLines.
+func (c *Chunker) outlineWalks() bool {
+	o := c.opts
+	if !c.parser.HasGrammar() || o.Dedent || o.StripComments || o.GroupMethods || o.Dedupe ||
+		o.LineMarkers || o.PrependStyleImports || o.MaxLines > 0 || o.MaxBytes > 0 {
+		return false
+	}
+	maxChars := c.maxChars()
+	for _, line := range c.sourceLines {
+		if len(line) > maxChars {
+			return false
+		}
 	}
+	return true
+}
 
+// TotalTokens sums the estimated token cost of chunks, e.g. to budget an API
+// call before sending them.
+func TotalTokens(chunks []Chunk) int {
+	total := 0
 	for i := range chunks {
-		chunks[i].TotalChunks = len(chunks)
-		chunks[i].CurrentChunk = i
-		chunks[i].HasMore = i < len(chunks)-1
-		chunks[i].Context = extractContext(chunks[i].Content)
+		total += chunks[i].Tokens
 	}
+	return total
+}
 
-	return chunks, nil
+// chunkBlank returns the single chunk for a file holding only whitespace.
+func (c *Chunker) chunkBlank() []Chunk {
+	chunks := []Chunk{{
+		Content:   c.linesToString(0, c.LineCount()-1),
+		StartLine: 1,
+		EndLine:   c.LineCount(),
+		Type:      "text",
+	}}
+	c.finalizeChunks(chunks)
+	return chunks
 }
 
 func (c *Chunker) chunkFallback() ([]Chunk, error) {
 	var chunks []Chunk
-	chunkSize := c.maxTokens * 4
+	chunkSize := c.maxChars()
 
-	for i := 0; i < len(c.sourceLines); i += chunkSize {
-		end := i + chunkSize
-		if end > len(c.sourceLines) {
-			end = len(c.sourceLines)
-		}
+	for i := 0; i < c.LineCount(); i += chunkSize {
+		end := min(i+chunkSize, c.LineCount())
 
 		content := strings.Join(c.sourceLines[i:end], "\n")
 		chunks = append(chunks, Chunk{
@@ -603,15 +1171,14 @@ func (c *Chunker) chunkFallback() ([]Chunk, error) {
 			Type:      "text",
 			Name:      "",
 		})
+		c.reportProgress(end)
 	}
 
 	for i := range chunks {
-		chunks[i].TotalChunks = len(chunks)
-		chunks[i].CurrentChunk = i
-		chunks[i].HasMore = i < len(chunks)-1
-		chunks[i].Context = extractContext(chunks[i].Content)
+		chunks[i].Context = c.extractContext(chunks[i].Content)
 	}
 
+	c.finalizeChunks(chunks)
 	return chunks, nil
 }
 
@@ -643,7 +1210,7 @@ func (c *Chunker) chunkMarkdown() ([]Chunk, error) {
 	for i := contentStart; i < len(c.sourceLines); i++ {
 		trimmed := strings.TrimSpace(c.sourceLines[i])
 
-		if strings.HasPrefix(trimmed, "```") {
+		if isCodeFence(trimmed) {
 			inCodeBlock = !inCodeBlock
 			continue
 		}
@@ -679,7 +1246,7 @@ func (c *Chunker) chunkMarkdown() ([]Chunk, error) {
 		for _, line := range c.sourceLines[1:contentStart] {
 			t := strings.TrimSpace(line)
 			if t != "" && t != "---" {
-				ctx = t
+				ctx = c.truncateContext(t)
 				break
 			}
 		}
@@ -695,36 +1262,42 @@ func (c *Chunker) chunkMarkdown() ([]Chunk, error) {
 
 	// No headings → single chunk (or fallback)
 	if len(headings) == 0 {
-		content := strings.Join(c.sourceLines[contentStart:], "\n")
-		tokens := estimateTokens(content)
-		if tokens <= c.maxTokens {
+		content := c.linesToString(contentStart, c.LineCount()-1)
+		switch {
+		case contentStart >= c.LineCount():
+			// Nothing follows the frontmatter
+		case c.estimateTokens(content) <= c.maxTokens:
 			chunks = append(chunks, Chunk{
 				Content:   content,
 				StartLine: contentStart + 1,
-				EndLine:   len(c.sourceLines),
+				EndLine:   c.LineCount(),
 				Type:      "text",
-				Context:   extractMarkdownContext(content),
+				Context:   c.extractMarkdownContext(content),
 			})
-		} else {
-			// Fall back to line-based splitting
-			fb, _ := c.chunkFallback()
-			chunks = append(chunks, fb...)
+		default:
+			// Fall back to line-based splitting of the lines after the
+			// frontmatter
+			chunks = append(chunks, c.splitLineRange(contentStart, c.LineCount()-1, "text", "")...)
 		}
 		c.finalizeChunks(chunks)
 		return chunks, nil
 	}
 
-	// Preamble: content before first heading
+	// Preamble: content before first heading. Blank lines between the
+	// frontmatter and the first heading go with the frontmatter.
 	if headings[0].line > contentStart {
 		preambleLines := c.sourceLines[contentStart:headings[0].line]
 		content := strings.Join(preambleLines, "\n")
-		if strings.TrimSpace(content) != "" {
+		if strings.TrimSpace(content) == "" && len(chunks) > 0 {
+			chunks[0].Content += "\n" + content
+			chunks[0].EndLine = headings[0].line
+		} else {
 			chunks = append(chunks, Chunk{
 				Content:   content,
 				StartLine: contentStart + 1,
 				EndLine:   headings[0].line,
 				Type:      "text",
-				Context:   extractMarkdownContext(content),
+				Context:   c.extractMarkdownContext(content),
 			})
 		}
 	}
@@ -737,67 +1310,307 @@ func (c *Chunker) chunkMarkdown() ([]Chunk, error) {
 		}
 	}
 
-	// Pass 2: create a chunk for each heading
+	// Heading paths, e.g. "Guide > Setup > Linux", kept on a stack that
+	// always holds the path to the current heading.
+	breadcrumbs := make([]string, len(headings))
+	var ancestors []heading
 	for i, h := range headings {
-		endLine := len(c.sourceLines) - 1
-		if i+1 < len(headings) {
-			endLine = headings[i+1].line - 1
+		for len(ancestors) > 0 && ancestors[len(ancestors)-1].level >= h.level {
+			ancestors = ancestors[:len(ancestors)-1]
 		}
+		ancestors = append(ancestors, h)
+		titles := make([]string, len(ancestors))
+		for j, a := range ancestors {
+			titles[j] = a.text
+		}
+		breadcrumbs[i] = strings.Join(titles, " > ")
+	}
 
-		content := strings.Join(c.sourceLines[h.line:endLine+1], "\n")
-		tokens := estimateTokens(content)
+	section := func(i, start, end int, name string) Chunk {
+		content := strings.Join(c.sourceLines[start:end+1], "\n")
+		return Chunk{
+			Content:    content,
+			StartLine:  start + 1,
+			EndLine:    end + 1,
+			Type:       "section",
+			Name:       name,
+			Breadcrumb: breadcrumbs[i],
+			Depth:      headings[i].level - minLevel,
+			Context:    c.extractMarkdownContext(content),
+		}
+	}
 
-		depth := h.level - minLevel
+	// splitLines cuts lines start..end of heading i's section by line budget.
+	// A chunk never ends inside a code fence or list item: it runs on to the
+	// closing fence, or past the item's indented continuation lines, even if
+	// that overshoots the budget.
+	splitLines := func(i, start, end int) {
+		linesPerChunk := c.maxChars() / 60
+		if linesPerChunk < 20 {
+			linesPerChunk = 20
+		}
 
-		if tokens <= c.maxTokens {
-			chunks = append(chunks, Chunk{
-				Content:   content,
-				StartLine: h.line + 1,
-				EndLine:   endLine + 1,
-				Type:      "section",
-				Name:      h.text,
-				Depth:     depth,
-				Context:   extractMarkdownContext(content),
-			})
-		} else {
-			// Section too large -- split by line budget
-			linesPerChunk := (c.maxTokens * 4) / 60
-			if linesPerChunk < 20 {
-				linesPerChunk = 20
+		for offset := start; offset <= end; {
+			chunkEnd := offset + linesPerChunk - 1
+			if chunkEnd > end {
+				chunkEnd = end
 			}
 
-			for offset := h.line; offset <= endLine; offset += linesPerChunk {
-				chunkEnd := offset + linesPerChunk - 1
-				if chunkEnd > endLine {
-					chunkEnd = endLine
+			inFence := false
+			for j := offset; j <= chunkEnd; j++ {
+				if isCodeFence(c.sourceLines[j]) {
+					inFence = !inFence
 				}
-
-				chunkContent := strings.Join(c.sourceLines[offset:chunkEnd+1], "\n")
-				name := ""
-				if offset == h.line {
-					name = h.text
-				} else {
-					name = h.text + " (cont.)"
+			}
+			for inFence && chunkEnd < end {
+				chunkEnd++
+				if isCodeFence(c.sourceLines[chunkEnd]) {
+					inFence = false
 				}
+			}
+			chunkEnd = c.endOfListItem(offset, chunkEnd, end)
 
-				chunks = append(chunks, Chunk{
-					Content:   chunkContent,
-					StartLine: offset + 1,
-					EndLine:   chunkEnd + 1,
-					Type:      "section",
-					Name:      name,
-					Depth:     depth,
-					Context:   extractMarkdownContext(chunkContent),
-				})
+			name := headings[i].text
+			if offset != headings[i].line {
+				name += " (cont.)"
+			}
+			chunks = append(chunks, section(i, offset, chunkEnd, name))
+			offset = chunkEnd + 1
+		}
+	}
+
+	// emit chunks heading i's section, which runs to line end. An oversized
+	// section is split at its child headings first, so sub-chunks follow the
+	// document's structure; only leaf sections fall back to line budgets.
+	var emit func(i, end int)
+	emit = func(i, end int) {
+		h := headings[i]
+		if c.linesTokens(h.line, end) <= c.maxTokens {
+			chunks = append(chunks, section(i, h.line, end, h.text))
+			return
+		}
+
+		// Children are the shallowest headings nested in the section
+		var children []int
+		childLevel := 7
+		for j := i + 1; j < len(headings) && headings[j].line <= end; j++ {
+			if headings[j].level < childLevel {
+				childLevel = headings[j].level
+				children = children[:0]
+			}
+			if headings[j].level == childLevel {
+				children = append(children, j)
+			}
+		}
+		if len(children) == 0 {
+			splitLines(i, h.line, end)
+			return
+		}
+
+		// The section's own text before its first child heading
+		if intro := headings[children[0]].line - 1; c.linesTokens(h.line, intro) <= c.maxTokens {
+			chunks = append(chunks, section(i, h.line, intro, h.text))
+		} else {
+			splitLines(i, h.line, intro)
+		}
+		for k, j := range children {
+			childEnd := end
+			if k+1 < len(children) {
+				childEnd = headings[children[k+1]].line - 1
 			}
+			emit(j, childEnd)
+		}
+	}
+
+	// Pass 2: create a chunk for each heading that starts a section. With
+	// MarkdownSplitLevels, deeper headings stay in the section above them,
+	// except that the first heading always starts one.
+	var starts []int
+	for i, h := range headings {
+		if c.opts.MarkdownSplitLevels <= 0 || h.level <= c.opts.MarkdownSplitLevels || len(starts) == 0 {
+			starts = append(starts, i)
 		}
 	}
+	for k, i := range starts {
+		endLine := c.LineCount() - 1
+		if k+1 < len(starts) {
+			endLine = headings[starts[k+1]].line - 1
+		}
+		emit(i, endLine)
+	}
 
 	c.finalizeChunks(chunks)
 	return chunks, nil
 }
 
+// capChunkLines splits every chunk whose content exceeds Options.MaxLines
+// lines.
+func (c *Chunker) capChunkLines(chunks []Chunk) []Chunk {
+	maxLines := c.opts.MaxLines
+	var capped []Chunk
+	for _, chunk := range chunks {
+		lines := strings.Split(chunk.Content, "\n")
+		if len(lines) <= maxLines {
+			capped = append(capped, chunk)
+			continue
+		}
+
+		for offset := 0; offset < len(lines); offset += maxLines {
+			end := offset + maxLines
+			if end > len(lines) {
+				end = len(lines)
+			}
+
+			piece := chunk
+			piece.Content = strings.Join(lines[offset:end], "\n")
+			piece.StartLine = chunk.StartLine + offset
+			piece.EndLine = chunk.StartLine + end - 1
+			if offset > 0 {
+				if chunk.Name != "" {
+					piece.Name = chunk.Name + " (cont.)"
+				}
+				piece.Context = c.extractContext(piece.Content)
+			}
+			capped = append(capped, piece)
+		}
+	}
+	return capped
+}
+
+// capChunkBytes splits every chunk whose content exceeds Options.MaxBytes
+// bytes. Each piece packs as many whole lines as fit; a line longer than the
+// cap is cut into pieces of its own, told apart by StartByte/EndByte as
+// splitLongLines does.
+func (c *Chunker) capChunkBytes(chunks []Chunk) []Chunk {
+	maxBytes := c.opts.MaxBytes
+	var capped []Chunk
+	for _, chunk := range chunks {
+		if len(chunk.Content) <= maxBytes {
+			capped = append(capped, chunk)
+			continue
+		}
+
+		lines := strings.Split(chunk.Content, "\n")
+		first := len(capped)
+		runStart, runBytes := 0, -1 // runBytes counts the newlines between lines
+		emitRun := func(end int) {
+			if end <= runStart {
+				return
+			}
+			piece := chunk
+			piece.Content = strings.Join(lines[runStart:end], "\n")
+			piece.StartLine = chunk.StartLine + runStart
+			piece.EndLine = chunk.StartLine + end - 1
+			capped = append(capped, piece)
+		}
+
+		for i, line := range lines {
+			if len(line) > maxBytes {
+				emitRun(i)
+				runStart, runBytes = i+1, -1
+
+				lineNum := chunk.StartLine + i
+				offset := c.contentLineOffset(chunk, lineNum)
+				if chunk.EndByte != 0 {
+					offset = chunk.StartByte
+				}
+				for k, text := range splitLine(line, maxBytes) {
+					piece := chunk
+					piece.Content = text
+					piece.StartLine = lineNum
+					piece.EndLine = lineNum
+					piece.StartByte = offset
+					piece.EndByte = offset + len(text)
+					if k > 0 {
+						piece.Indent = ""
+					}
+					offset += len(text)
+					capped = append(capped, piece)
+				}
+				continue
+			}
+			if runBytes+1+len(line) > maxBytes {
+				emitRun(i)
+				runStart, runBytes = i, -1
+			}
+			runBytes += 1 + len(line)
+		}
+		emitRun(len(lines))
+
+		for i := first + 1; i < len(capped); i++ {
+			if chunk.Name != "" {
+				capped[i].Name = chunk.Name + " (cont.)"
+			}
+			capped[i].Context = c.extractContext(capped[i].Content)
+		}
+	}
+	return capped
+}
+
 func (c *Chunker) finalizeChunks(chunks []Chunk) {
+	seen := make(map[string]int)
+	testFile := isTestFile(c.filePath)
+	for i := range chunks {
+		c.finalizeChunk(&chunks[i], seen, testFile)
+	}
+	numberChunks(chunks)
+	linkNames(chunks, c.opts.PeekNext)
+}
+
+// finalizeChunk fills in the fields derived from a chunk's content. seen
+// counts the StableIDs handed out so far in the file, so identical chunks
+// get distinct IDs.
+func (c *Chunker) finalizeChunk(chunk *Chunk, seen map[string]int, testFile bool) {
+	// Pieces of a split long line don't start at a line start, and a chunk
+	// finalized before has already been dedented
+	if chunk.EndByte == 0 && chunk.Indent == "" {
+		indent := commonIndent(chunk.Content)
+		chunk.BaseIndent = len(indent)
+		if c.opts.Dedent && indent != "" {
+			chunk.Content = dedent(chunk.Content, indent)
+			chunk.Indent = indent
+		}
+	}
+
+	chunk.FilePath = c.filePath
+	if c.outlineOnly && chunk.Content == "" {
+		// The chunk's lines stand in for the Content chunkAST didn't build
+		chunk.Hash = c.hashLines(chunk.StartLine-1, chunk.EndLine-1)
+		chunk.Tokens = c.linesTokens(chunk.StartLine-1, chunk.EndLine-1)
+	} else {
+		chunk.Hash = hashContent(chunk.Content)
+		chunk.Tokens = c.estimateTokens(chunk.Content)
+	}
+	chunk.IsTest = testFile || isTestChunk(*chunk)
+
+	id := stableID(c.filePath, chunk.QualifiedName(), chunk.Hash, 0)
+	if n := seen[id]; n > 0 {
+		chunk.StableID = stableID(c.filePath, chunk.QualifiedName(), chunk.Hash, n)
+	} else {
+		chunk.StableID = id
+	}
+	seen[id]++
+}
+
+// linkNames sets each chunk's PrevName from the chunk before it and, if
+// next is set, its NextName from the chunk after it.
+func linkNames(chunks []Chunk, next bool) {
+	for i := range chunks {
+		chunks[i].PrevName, chunks[i].NextName = "", ""
+		if i > 0 {
+			chunks[i].PrevName = chunks[i-1].Name
+		}
+		if next && i+1 < len(chunks) {
+			chunks[i].NextName = chunks[i+1].Name
+			if chunks[i].NextName == "" {
+				chunks[i].NextName = chunks[i+1].Signature
+			}
+		}
+	}
+}
+
+// numberChunks sets each chunk's position within chunks.
+func numberChunks(chunks []Chunk) {
 	for i := range chunks {
 		chunks[i].TotalChunks = len(chunks)
 		chunks[i].CurrentChunk = i
@@ -805,7 +1618,100 @@ func (c *Chunker) finalizeChunks(chunks []Chunk) {
 	}
 }
 
-func extractMarkdownContext(content string) string {
+// QualifiedName is the chunk's name within the file: the heading path for
+// markdown, "Parent.Name" for members, or just Name.
+func (ch Chunk) QualifiedName() string {
+	switch {
+	case ch.Breadcrumb != "":
+		return ch.Breadcrumb
+	case ch.Parent != "" && ch.Name != "":
+		return ch.Parent + "." + ch.Name
+	default:
+		return ch.Name
+	}
+}
+
+// stableID derives a chunk ID from what the chunk is rather than where it is,
+// so editing one function doesn't change the IDs of the others in the file.
+// Renaming, moving to another file or editing the chunk itself does change
+// it. Chunks identical in name and content are told apart by occurrence,
+// which is only stable while their order is.
+func stableID(filePath, qualifiedName, hash string, occurrence int) string {
+	key := filePath + "\x00" + qualifiedName + "\x00" + hash
+	if occurrence > 0 {
+		key += fmt.Sprintf("\x00%d", occurrence)
+	}
+	return hashContent(key)
+}
+
+// FindChunkByLine returns the chunk whose [StartLine, EndLine] range contains
+// the given 1-based line. Chunks must be ordered by line, as ChunkFile returns
+// them. If ranges overlap, the first (earliest) matching chunk is returned.
+func FindChunkByLine(chunks []Chunk, line int) (*Chunk, bool) {
+	i := sort.Search(len(chunks), func(i int) bool {
+		return chunks[i].EndLine >= line
+	})
+	if i < len(chunks) && chunks[i].StartLine <= line {
+		return &chunks[i], true
+	}
+	return nil, false
+}
+
+// hashContent returns the hex SHA-256 of a chunk's content. Consumers can
+// compare hashes between runs to skip re-embedding unchanged chunks.
+func hashContent(content string) string {
+	return fmt.Sprintf("%x", sha256.Sum256([]byte(content)))
+}
+
+// hashLines returns hashContent(c.linesToString(start, end)) without
+// building the string: the lines are hashed where they sit in sourceCode.
+func (c *Chunker) hashLines(start, end int) string {
+	offset := c.lineOffset(max(start, 0))
+	return fmt.Sprintf("%x", sha256.Sum256(c.sourceCode[offset:offset+c.linesLen(start, end)]))
+}
+
+// isCodeFence reports whether a markdown line opens or closes a ``` block.
+func isCodeFence(line string) bool {
+	return strings.HasPrefix(strings.TrimSpace(line), "```")
+}
+
+// isListItem reports whether a markdown line starts a bulleted or numbered
+// list item, such as "- item", "* item" or "2. item".
+func isListItem(line string) bool {
+	trimmed := strings.TrimSpace(line)
+	if strings.HasPrefix(trimmed, "- ") || strings.HasPrefix(trimmed, "* ") || strings.HasPrefix(trimmed, "+ ") {
+		return true
+	}
+	digits := len(trimmed) - len(strings.TrimLeft(trimmed, "0123456789"))
+	return digits > 0 && (strings.HasPrefix(trimmed[digits:], ". ") || strings.HasPrefix(trimmed[digits:], ") "))
+}
+
+// endOfListItem returns where a markdown chunk covering lines start..last
+// should end so that it doesn't cut a list item off from its indented
+// continuation lines, including any nested items and blank lines between
+// them: last itself, or the item's last indented line up to limit.
+func (c *Chunker) endOfListItem(start, last, limit int) int {
+	inItem := false
+	for j := start; j <= last; j++ {
+		line := c.sourceLines[j]
+		if strings.TrimSpace(line) != "" && line[0] != ' ' && line[0] != '\t' {
+			inItem = isListItem(line)
+		}
+	}
+	for inItem && last < limit {
+		next := last + 1
+		for next < limit && strings.TrimSpace(c.sourceLines[next]) == "" {
+			next++
+		}
+		if line := c.sourceLines[next]; line == "" || line[0] != ' ' && line[0] != '\t' {
+			break
+		}
+		last = next
+	}
+	return last
+}
+
+func (c *Chunker) extractMarkdownContext(content string) string {
 	lines := strings.Split(content, "\n")
 	for _, line := range lines {
 		trimmed := strings.TrimSpace(line)
@@ -813,38 +1719,99 @@ func extractMarkdownContext(content string) string {
 		if trimmed == "" || strings.HasPrefix(trimmed, "#") || strings.HasPrefix(trimmed, "```") || trimmed == "---" {
 			continue
 		}
-		if len(trimmed) > 70 {
-			return trimmed[:67] + "..."
-		}
-		return trimmed
+		return c.truncateContext(trimmed)
 	}
 	return ""
 }
 
-func (c *Chunker) getLinesRange(start, end int) string {
+// truncateContext cuts s to Options.ContextLength characters, ending it in
+// "..." when anything was cut. It cuts by rune rather than byte, so the
+// result is valid UTF-8 however many bytes its characters take.
+func (c *Chunker) truncateContext(s string) string {
+	n := c.opts.ContextLength
+	if utf8.RuneCountInString(s) <= n {
+		return s
+	}
+	runes := []rune(s)
+	if n <= len("...") {
+		return string(runes[:n])
+	}
+	return string(runes[:n-len("...")]) + "..."
+}
+
+// LineCount returns the number of lines in the file, not counting the empty
+// line after a trailing newline; it is the totalLines to pass to
+// ValidateChunks.
+func (c *Chunker) LineCount() int {
+	if len(c.sourceCode) == 0 {
+		return 0
+	}
+	n := len(c.sourceLines)
+	if n > 1 && c.sourceLines[n-1] == "" {
+		n--
+	}
+	return n
+}
+
+// linesToString returns the 0-based source lines start..end joined by
+// newlines, clamped to the file. It joins a subslice of sourceLines, so the
+// only allocation is the result.
+func (c *Chunker) linesToString(start, end int) string {
 	if start < 0 {
 		start = 0
 	}
 	if end >= len(c.sourceLines) {
 		end = len(c.sourceLines) - 1
 	}
+	if start > end {
+		return ""
+	}
+	return strings.Join(c.sourceLines[start:end+1], "\n")
+}
 
-	var lines []string
-	for i := start; i <= end; i++ {
-		lines = append(lines, c.sourceLines[i])
+// linesLen returns len(c.linesToString(start, end)) without building the
+// string, for sizing a range before deciding whether to keep it.
+func (c *Chunker) linesLen(start, end int) int {
+	if start < 0 {
+		start = 0
+	}
+	if end >= len(c.sourceLines) {
+		end = len(c.sourceLines) - 1
+	}
+	if start > end {
+		return 0
 	}
-	return strings.Join(lines, "\n")
+	n := end - start
+	for _, line := range c.sourceLines[start : end+1] {
+		n += len(line)
+	}
+	return n
+}
+
+func (c *Chunker) estimateTokens(text string) int {
+	return c.tokensForLen(len(text))
+}
+
+// linesTokens estimates the tokens of the 0-based lines start..end.
+func (c *Chunker) linesTokens(start, end int) int {
+	return c.tokensForLen(c.linesLen(start, end))
+}
+
+// tokensForLen estimates the tokens in n bytes of text.
+func (c *Chunker) tokensForLen(n int) int {
+	return int(float64(n) / c.opts.CharsPerToken)
 }
 
-func estimateTokens(text string) int {
-	return len(text) / 4
+// maxChars is the token budget in bytes.
+func (c *Chunker) maxChars() int {
+	return int(float64(c.maxTokens) * c.opts.CharsPerToken)
 }
 
 func extractNodeType(nodeType string) string {
 	switch nodeType {
 	case "class_declaration":
 		return "class"
-	case "function_declaration":
+	case "function_declaration", "function_signature":
 		return "function"
 	case "method_definition":
 		return "method"
@@ -858,6 +1825,7 @@ func extractNodeType(nodeType string) string {
 }
 
 func extractNodeName(node *sitter.Node, source string) string {
+	node = unwrapDeclaration(node)
 	for i := 0; i < int(node.ChildCount()); i++ {
 		child := node.Child(i)
 		if child.Type() == "identifier" || child.Type() == "type_identifier" {
@@ -871,6 +1839,148 @@ func extractNodeName(node *sitter.Node, source string) string {
 	return ""
 }
 
+// nodeLines returns the 0-indexed first and last line a node occupies. A node
+// that ends at column 0 (e.g. a text run including its trailing newline)
+// doesn't occupy the line its end point sits on, and leading whitespace, which
+// some grammars (Lua) include in a node, doesn't count towards its first line.
+func (c *Chunker) nodeLines(n *sitter.Node) (int, int) {
+	start := int(n.StartPoint().Row)
+	end := int(n.EndPoint().Row)
+	// The end line is settled first: skipping the leading newlines of a
+	// terminator such as Go's "\n\n" must not carry it onto the next line
+	if n.EndPoint().Column == 0 && end > start {
+		end--
+	}
+	for b := n.StartByte(); b < n.EndByte() && start < end; b++ {
+		ch := c.sourceCode[b]
+		if ch == '\n' {
+			start++
+		} else if ch != ' ' && ch != '\t' && ch != '\r' {
+			break
+		}
+	}
+	return start, end
+}
+
+// headerEnd returns the 0-based last line of the comments opening the file,
+// such as a license header or package comment, and of a Python module
+// docstring; or -1 if the file doesn't open with one. Comments directly
+// above the first declaration document it and are not part of the header.
+func (c *Chunker) headerEnd(root *sitter.Node, spec astSpec, source string) int {
+	end := -1
+	var block []int // start rows of the comments ending at end, without a blank line between
+	for i := 0; i < int(root.NamedChildCount()); i++ {
+		child := root.NamedChild(i)
+		start, stop := int(child.StartPoint().Row), int(child.EndPoint().Row)
+		docstring := i == 0 && c.parser.GetLanguage() == "python" && child.Type() == "expression_statement" &&
+			child.NamedChildCount() == 1 && child.NamedChild(0).Type() == "string"
+		if strings.Contains(child.Type(), "comment") || docstring {
+			if start > end+1 {
+				block = block[:0]
+			}
+			block = append(block, start)
+			end = stop
+			continue
+		}
+		if end >= 0 && start == end+1 && spec.isTarget(child, source) {
+			end = block[0] - 1
+			// Skip back over the blank lines above the declaration's comment
+			for end >= 0 && strings.TrimSpace(c.sourceLines[end]) == "" {
+				end--
+			}
+		}
+		break
+	}
+	return end
+}
+
+// hasErrorInRows reports whether an ERROR or MISSING node under n touches
+// the 0-based rows from..to.
+func hasErrorInRows(n *sitter.Node, from, to int) bool {
+	if !n.HasError() || int(n.EndPoint().Row) < from || int(n.StartPoint().Row) > to {
+		return false
+	}
+	if n.IsError() || n.IsMissing() {
+		return true
+	}
+	for i := 0; i < int(n.ChildCount()); i++ {
+		if hasErrorInRows(n.Child(i), from, to) {
+			return true
+		}
+	}
+	return false
+}
+
+// hasTargetDescendant reports whether any node below n starts a chunk.
+func hasTargetDescendant(n *sitter.Node, spec astSpec, source string) bool {
+	for i := 0; i < int(n.ChildCount()); i++ {
+		child := n.Child(i)
+		if child == nil {
+			continue
+		}
+		if spec.isTarget(child, source) || hasTargetDescendant(child, spec, source) {
+			return true
+		}
+	}
+	return false
+}
+
+// unwrapDeclaration returns the declaration inside wrappers such as export
+// statements and decorated definitions, or node itself.
+func unwrapDeclaration(node *sitter.Node) *sitter.Node {
+	for _, field := range []string{"declaration", "definition"} {
+		if inner := node.ChildByFieldName(field); inner != nil {
+			return inner
+		}
+	}
+	return node
+}
+
+// extractSignature returns a node's declaration header: the source from the
+// start of the node up to where its body begins, collapsed onto one line.
+// Decorators are skipped, and nodes without a body field use their first line.
+func (c *Chunker) extractSignature(node, root *sitter.Node) string {
+	if node == nil || node == root {
+		return ""
+	}
+
+	decl := unwrapDeclaration(node)
+
+	end := node.EndByte()
+	hasBody := false
+	if body := decl.ChildByFieldName("body"); body != nil && body.StartByte() > node.StartByte() {
+		end = body.StartByte()
+		hasBody = true
+	}
+	header := string(c.sourceCode[node.StartByte():end])
+
+	var parts []string
+	for _, line := range strings.Split(header, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if len(parts) == 0 && (trimmed == "" || strings.HasPrefix(trimmed, "@")) {
+			continue
+		}
+		parts = append(parts, trimmed)
+		if !hasBody {
+			break
+		}
+	}
+
+	sig := strings.Join(strings.Fields(strings.Join(parts, " ")), " ")
+	sig = strings.TrimSpace(strings.TrimSuffix(sig, "{"))
+	return strings.TrimSpace(strings.TrimSuffix(sig, ":"))
+}
+
+// extractFieldName returns the source text of a node's "name" field, which
+// most grammars use for the identifier of a declaration.
+func extractFieldName(node *sitter.Node, source string) string {
+	name := node.ChildByFieldName("name")
+	if name == nil || int(name.EndByte()) > len(source) {
+		return ""
+	}
+	return source[name.StartByte():name.EndByte()]
+}
+
 func extractPythonNodeType(nodeType string) string {
 	switch nodeType {
 	case "class_definition":
@@ -901,37 +2011,224 @@ func extractGoNodeType(nodeType string) string {
 	}
 }
 
-func extractContext(content string) string {
+// extractGoNodeName names functions and methods by their name field, and
+// type, const and var declarations by their first spec.
+func extractGoNodeName(node *sitter.Node, source string) string {
+	switch node.Type() {
+	case "type_declaration", "const_declaration", "var_declaration":
+		for i := 0; i < int(node.NamedChildCount()); i++ {
+			if name := extractFieldName(node.NamedChild(i), source); name != "" {
+				return name
+			}
+		}
+		return ""
+	default:
+		return extractFieldName(node, source)
+	}
+}
+
+// extractGoReceiverType returns the receiver type of a method declaration,
+// without pointer or type parameters: "Repo" for `func (r *Repo[T]) Get()`.
+func extractGoReceiverType(node *sitter.Node, source string) string {
+	receiver := node.ChildByFieldName("receiver")
+	if receiver == nil {
+		return ""
+	}
+	for i := 0; i < int(receiver.NamedChildCount()); i++ {
+		param := receiver.NamedChild(i)
+		if param.Type() != "parameter_declaration" {
+			continue
+		}
+		typ := param.ChildByFieldName("type")
+		if typ == nil {
+			return ""
+		}
+		name := strings.TrimLeft(source[typ.StartByte():typ.EndByte()], "*")
+		if i := strings.Index(name, "["); i >= 0 {
+			name = name[:i]
+		}
+		return strings.TrimSpace(name)
+	}
+	return ""
+}
+
+// commentMarkers gives, per language, the prefixes that start a comment or
+// docstring line (longer ones first) and the closing markers to strip from
+// its end. Languages not listed use cStyleComments.
+var commentMarkers = map[string]commentSyntax{
+	"python":     {open: []string{`"""`, "'''", "#"}, close: []string{`"""`, "'''"}},
+	"ruby":       {open: []string{"#"}},
+	"bash":       {open: []string{"#"}},
+	"yaml":       {open: []string{"#"}},
+	"dockerfile": {open: []string{"#"}},
+	"graphql":    {open: []string{`"""`, "#", `"`}, close: []string{`"""`, `"`}},
+	"elixir":     {open: []string{"@moduledoc", "@doc", "#"}},
+	"lua":        {open: []string{"--[[", "--"}, close: []string{"]]"}},
+	"zig":        {open: []string{"///", "//!", "//"}},
+	"dart":       {open: []string{"///", "//", "/**", "/*", "*"}, close: []string{"*/"}},
+	"haskell":    {open: []string{"-- |", "-- ^", "--", "{-|", "{-"}, close: []string{"-}"}},
+	"objc":       {open: []string{"///", "//", "/**", "/*", "*"}, close: []string{"*/"}},
+	"perl":       {open: []string{"#"}},
+	"r":          {open: []string{"#'", "#"}},
+	"julia":      {open: []string{`"""`, "#=", "#"}, close: []string{`"""`, "=#"}},
+	"wat":        {open: []string{";;", "(;"}, close: []string{";)"}},
+	"ini":        {open: []string{";", "#"}},
+	"env":        {open: []string{"#"}},
+	"properties": {open: []string{"#", "!"}},
+	"sql":        {open: []string{"--", "/**", "/*", "*"}, close: []string{"*/"}},
+	"hcl":        {open: []string{"#", "//", "/**", "/*", "*"}, close: []string{"*/"}},
+	"php":        {open: []string{"//", "/*", "*", "#"}, close: []string{"*/"}},
+	"html":       {open: []string{"<!--"}, close: []string{"-->"}},
+}
+
+type commentSyntax struct {
+	open, close []string
+}
+
+var cStyleComments = commentSyntax{open: []string{"//", "/**", "/*", "*"}, close: []string{"*/"}}
+
+// boilerplatePrefixes lists, per language, the directives that open a file
+// without saying anything about it: package clauses, imports, includes and
+// the like. Languages not listed only skip imports.
+var boilerplatePrefixes = map[string][]string{
+	"go":         {"package ", "import "},
+	"python":     {"import ", "from ", "#!"},
+	"typescript": {"import ", `"use strict"`, "'use strict'"},
+	"javascript": {"import ", `"use strict"`, "'use strict'"},
+	"ruby":       {"require ", "require_relative ", "#!", "# frozen_string_literal"},
+	"php":        {"<?php", "namespace ", "use ", "require", "include", "declare("},
+	"csharp":     {"using "},
+	"kotlin":     {"package ", "import "},
+	"scala":      {"package ", "import "},
+	"swift":      {"import "},
+	"dart":       {"import ", "export ", "part ", "library ", "@override"},
+	"haskell":    {"module ", "import ", "{-#"},
+	"objc":       {"#import ", "#include ", "@import ", "@class ", "NS_ASSUME_NONNULL_"},
+	"perl":       {"#!", "use ", "no ", "require "},
+	"r":          {"#!", "library(", "require(", "source("},
+	"julia":      {"using ", "import ", "export ", "include("},
+	"elixir":     {"alias ", "import ", "require ", "use "},
+	"lua":        {"require"},
+	"bash":       {"#!", "set -"},
+	"proto":      {"syntax ", "package ", "import "},
+	"scss":       {"@use ", "@import ", "@forward "},
+	"less":       {"@import "},
+	"css":        {"@import ", "@charset "},
+	"dockerfile": {"# syntax=", "# escape="},
+}
+
+// isBoilerplate reports whether a trimmed line is one of lang's
+// boilerplatePrefixes.
+func isBoilerplate(lang, trimmed string) bool {
+	prefixes, ok := boilerplatePrefixes[lang]
+	if !ok {
+		prefixes = []string{"import "}
+	}
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(trimmed, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// extractContext returns the chunk's first comment or docstring, found with
+// the file language's comment markers, or failing that its first line that
+// isn't boilerplate such as a package clause or import. Comments set apart
+// by a blank line above a commented declaration, such as a license header
+// or section divider, are passed over for the declaration's own comment.
+func (c *Chunker) extractContext(content string) string {
+	lang := c.parser.GetLanguage()
+	markers, ok := commentMarkers[lang]
+	if !ok {
+		markers = cStyleComments
+	}
+
 	lines := strings.Split(content, "\n")
+	lines = lines[detachedComments(lines, lang, markers):]
+	opened := false // a marker stood alone on the previous line, e.g. `"""`
 	for _, line := range lines {
 		trimmed := strings.TrimSpace(line)
-		if strings.HasPrefix(trimmed, "//") || strings.HasPrefix(trimmed, "/*") || strings.HasPrefix(trimmed, "*") {
-			comment := strings.TrimPrefix(trimmed, "//")
-			comment = strings.TrimPrefix(comment, "/*")
-			comment = strings.TrimPrefix(comment, "*")
-			comment = strings.TrimSpace(comment)
-			if len(comment) > 60 {
-				return comment[:60]
+		if isBoilerplate(lang, trimmed) {
+			continue
+		}
+		for _, close := range markers.close {
+			// A line holding only `*/` ends a comment; one holding only
+			// `"""` may open one
+			if trimmed == close && !contains(markers.open, close) {
+				trimmed = ""
+			} else if trimmed != close {
+				trimmed = strings.TrimSpace(strings.TrimSuffix(trimmed, close))
+			}
+		}
+		comment, found := "", false
+		for _, open := range markers.open {
+			if strings.HasPrefix(trimmed, open) {
+				comment, found = strings.TrimPrefix(trimmed, open), true
+				break
 			}
-			if len(comment) > 0 {
-				return comment
+		}
+		if !found {
+			if !opened || trimmed == "" {
+				continue
 			}
+			comment = trimmed
+		}
+		comment = strings.Trim(strings.TrimSpace(comment), `"`)
+		// A divider such as `// -----` says nothing about the code
+		if strings.IndexFunc(comment, isWordRune) < 0 {
+			comment = ""
+		}
+		if len(comment) > 0 {
+			return c.truncateContext(comment)
 		}
+		opened = true
 	}
 
 	for _, line := range lines {
 		trimmed := strings.TrimSpace(line)
-		if len(trimmed) > 0 && !strings.HasPrefix(trimmed, "import") {
-			if len(trimmed) > 60 {
-				return trimmed[:60]
-			}
-			return trimmed
+		if len(trimmed) > 0 && !isBoilerplate(lang, trimmed) {
+			return c.truncateContext(trimmed)
 		}
 	}
 
 	return "Code chunk"
 }
 
+// detachedComments returns the number of leading lines holding comments
+// separated by a blank line from the comment directly above the first line
+// of code, or 0 if no comment sits directly above it.
+func detachedComments(lines []string, lang string, markers commentSyntax) int {
+	isComment := func(trimmed string) bool {
+		for _, open := range markers.open {
+			if strings.HasPrefix(trimmed, open) {
+				return true
+			}
+		}
+		return false
+	}
+	blank := -1 // last blank line before the code
+	for i, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		switch {
+		case trimmed == "":
+			blank = i
+		case isBoilerplate(lang, trimmed) || isComment(trimmed):
+		default:
+			if blank >= 0 && isComment(strings.TrimSpace(lines[i-1])) {
+				return blank + 1
+			}
+			return 0
+		}
+	}
+	return 0
+}
+
+// isWordRune reports whether r is a letter or digit.
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
 func extractNamesFromContent(content string) string {
 	lines := strings.Split(content, "\n")
 	var names []string
@@ -0,0 +1,224 @@
+package chunker
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	sitter "github.com/smacker/go-tree-sitter"
+)
+
+// shapesSource chunks into Area (lines 1-5), Perimeter (6-9) and Diagonal
+// (10-13) at a budget of 20 tokens.
+const shapesSource = `package shapes
+
+func Area(w, h int) int {
+	return w * h
+}
+
+func Perimeter(w, h int) int {
+	return 2 * (w + h)
+}
+
+func Diagonal(w, h int) float64 {
+	return math.Sqrt(float64(w*w + h*h))
+}
+`
+
+// chunkSource chunks source as path with opts, failing the test on error.
+func chunkSource(t *testing.T, path, source string, opts Options) []Chunk {
+	t.Helper()
+	c, err := NewChunkerWithOptions(path, []byte(source), opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	chunks, err := c.ChunkFile()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return chunks
+}
+
+// startLines returns the StartLine of each chunk.
+func startLines(chunks []Chunk) []int {
+	lines := make([]int, len(chunks))
+	for i := range chunks {
+		lines[i] = chunks[i].StartLine
+	}
+	return lines
+}
+
+// qualifiedNames returns the QualifiedName of each chunk.
+func qualifiedNames(chunks []Chunk) []string {
+	names := make([]string, len(chunks))
+	for i := range chunks {
+		names[i] = chunks[i].QualifiedName()
+	}
+	return names
+}
+
+func TestFindChunkByLine(t *testing.T) {
+	chunks := chunkSource(t, "shapes.go", shapesSource, Options{MaxTokens: 20})
+	tests := []struct {
+		line int
+		want int // StartLine of the chunk found, or 0 for none
+	}{
+		{0, 0},
+		{1, 1},
+		{5, 1},
+		{6, 6},
+		{9, 6},
+		{10, 10},
+		{13, 10},
+		{14, 0},
+	}
+	for _, tt := range tests {
+		ch, ok := FindChunkByLine(chunks, tt.line)
+		got := 0
+		if ok {
+			got = ch.StartLine
+		}
+		if got != tt.want {
+			t.Errorf("FindChunkByLine(%d) found the chunk on line %d, want %d", tt.line, got, tt.want)
+		}
+	}
+}
+
+func TestChunkRange(t *testing.T) {
+	tests := []struct {
+		start, end int
+		want       []int // StartLine of each chunk
+		err        error
+	}{
+		{3, 3, []int{3}, nil},
+		{4, 4, []int{3}, nil},
+		{7, 11, []int{7, 10}, nil},
+		{1, 13, []int{1, 6, 10}, nil},
+		{12, 99, []int{11}, nil},
+		{0, 3, nil, ErrInvalidRange},
+		{5, 4, nil, ErrInvalidRange},
+		{14, 20, nil, ErrInvalidRange},
+	}
+	for _, tt := range tests {
+		c, err := NewChunkerWithOptions("shapes.go", []byte(shapesSource), Options{MaxTokens: 20})
+		if err != nil {
+			t.Fatal(err)
+		}
+		chunks, err := c.ChunkRange(tt.start, tt.end)
+		if !errors.Is(err, tt.err) {
+			t.Errorf("ChunkRange(%d, %d) error = %v, want %v", tt.start, tt.end, err, tt.err)
+			continue
+		}
+		if got := startLines(chunks); err == nil && !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("ChunkRange(%d, %d) chunks start on lines %v, want %v", tt.start, tt.end, got, tt.want)
+		}
+	}
+}
+
+func TestMaxChunks(t *testing.T) {
+	tests := []struct {
+		maxChunks int
+		want      []int // StartLine of each chunk
+	}{
+		{0, []int{1, 6, 10}},
+		{1, []int{1}},
+		{2, []int{1, 10}},
+		{3, []int{1, 6, 10}},
+		{10, []int{1, 6, 10}},
+	}
+	for _, tt := range tests {
+		c, err := NewChunkerWithOptions("shapes.go", []byte(shapesSource), Options{MaxTokens: 20, MaxChunks: tt.maxChunks})
+		if err != nil {
+			t.Fatal(err)
+		}
+		chunks, err := c.ChunkFile()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := startLines(chunks); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("MaxChunks %d: chunks start on lines %v, want %v", tt.maxChunks, got, tt.want)
+		}
+		if err := ValidateChunks(chunks, c.LineCount()); err != nil {
+			t.Errorf("MaxChunks %d: %v", tt.maxChunks, err)
+		}
+	}
+}
+
+func TestMaxDepth(t *testing.T) {
+	source := "class Shape:\n    def area(self):\n        return self.w * self.h\n\n    def perimeter(self):\n        return 2 * (self.w + self.h)\n\n\ndef helper():\n    return 1\n"
+	tests := []struct {
+		maxDepth int
+		want     []string
+	}{
+		{0, []string{"Shape.area", "Shape.perimeter", "helper"}},
+		{1, []string{"Shape", "Shape", "helper"}},
+		{2, []string{"Shape.area", "Shape.perimeter", "helper"}},
+	}
+	for _, tt := range tests {
+		chunks := chunkSource(t, "shapes.py", source, Options{MaxTokens: 20, MaxDepth: tt.maxDepth})
+		if got := qualifiedNames(chunks); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("MaxDepth %d: chunks %q, want %q", tt.maxDepth, got, tt.want)
+		}
+	}
+}
+
+func TestStableID(t *testing.T) {
+	base := chunkSource(t, "shapes.go", shapesSource, Options{MaxTokens: 20})
+	tests := []struct {
+		name   string
+		path   string
+		source string
+		same   []bool // whether each chunk keeps its StableID
+	}{
+		{"unchanged", "shapes.go", shapesSource, []bool{true, true, true}},
+		{"other function edited", "shapes.go", strings.Replace(shapesSource, "2 * (w + h)", "(w + h) * 2", 1), []bool{true, false, true}},
+		{"renamed", "shapes.go", strings.Replace(shapesSource, "Diagonal", "Hypotenuse", 1), []bool{true, true, false}},
+		{"moved to another file", "geometry/shapes.go", shapesSource, []bool{false, false, false}},
+	}
+	for _, tt := range tests {
+		chunks := chunkSource(t, tt.path, tt.source, Options{MaxTokens: 20})
+		if len(chunks) != len(base) {
+			t.Fatalf("%s: %d chunks, want %d", tt.name, len(chunks), len(base))
+		}
+		for i := range chunks {
+			if same := chunks[i].StableID == base[i].StableID; same != tt.same[i] {
+				t.Errorf("%s: chunk %q kept its StableID: %v, want %v", tt.name, chunks[i].Name, same, tt.same[i])
+			}
+		}
+	}
+}
+
+func TestNameExtractor(t *testing.T) {
+	// funcName names function declarations after their name field, as
+	// rename does, and leaves other nodes to the built-in names
+	funcName := func(rename func(string) string) func(*sitter.Node, []byte) string {
+		return func(node *sitter.Node, source []byte) string {
+			if node.Type() != "function_declaration" {
+				return ""
+			}
+			return rename(node.ChildByFieldName("name").Content(source))
+		}
+	}
+	tests := []struct {
+		name      string
+		extractor func(*sitter.Node, []byte) string
+		want      []string
+	}{
+		{"none", nil, []string{"Area", "Perimeter", "Diagonal"}},
+		{"every name", funcName(strings.ToLower), []string{"area", "perimeter", "diagonal"}},
+		{"empty falls back", funcName(func(string) string { return "" }), []string{"Area", "Perimeter", "Diagonal"}},
+		{"some names", funcName(func(name string) string {
+			if name == "Perimeter" {
+				return "perim"
+			}
+			return ""
+		}), []string{"Area", "perim", "Diagonal"}},
+	}
+	for _, tt := range tests {
+		chunks := chunkSource(t, "shapes.go", shapesSource, Options{MaxTokens: 20, NameExtractor: tt.extractor})
+		if got := qualifiedNames(chunks); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: chunks %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,50 @@
+package chunker
+
+// ChunkSet wraps a file's chunks with common queries so consumers don't each
+// write the same loops over a []Chunk. Filtering returns a new set and never
+// modifies the one it was called on.
+type ChunkSet struct {
+	chunks []Chunk
+}
+
+// ChunkFileSet chunks the file like ChunkFile and returns the result as a
+// ChunkSet.
+func (c *Chunker) ChunkFileSet() (ChunkSet, error) {
+	chunks, err := c.ChunkFile()
+	if err != nil {
+		return ChunkSet{}, err
+	}
+	return ChunkSet{chunks: chunks}, nil
+}
+
+// Len returns the number of chunks in the set.
+func (s ChunkSet) Len() int {
+	return len(s.chunks)
+}
+
+// At returns the i-th chunk. It panics if i is out of range, like indexing a
+// slice.
+func (s ChunkSet) At(i int) Chunk {
+	return s.chunks[i]
+}
+
+// Chunks returns the set's chunks as a slice.
+func (s ChunkSet) Chunks() []Chunk {
+	return s.chunks
+}
+
+// Filter returns the chunks for which keep returns true, in order.
+func (s ChunkSet) Filter(keep func(Chunk) bool) ChunkSet {
+	var out []Chunk
+	for i := range s.chunks {
+		if keep(s.chunks[i]) {
+			out = append(out, s.chunks[i])
+		}
+	}
+	return ChunkSet{chunks: out}
+}
+
+// ByType returns the chunks whose Type is t, e.g. "function".
+func (s ChunkSet) ByType(t string) ChunkSet {
+	return s.Filter(func(ch Chunk) bool { return ch.Type == t })
+}
@@ -0,0 +1,56 @@
+package chunker
+
+import (
+	sitter "github.com/smacker/go-tree-sitter"
+)
+
+// csharpSpec descends through oversized namespaces into their types and
+// through oversized types into their members, since C# files usually wrap
+// everything in a namespace.
+var csharpSpec = astSpec{
+	targets: map[string]bool{
+		"namespace_declaration":             true,
+		"file_scoped_namespace_declaration": true,
+		"class_declaration":                 true,
+		"interface_declaration":             true,
+		"struct_declaration":                true,
+		"record_declaration":                true,
+		"enum_declaration":                  true,
+		"method_declaration":                true,
+		"constructor_declaration":           true,
+		"property_declaration":              true,
+	},
+	typeName: extractCSharpNodeType,
+	nodeName: extractFieldName,
+	calls:    csharpCalls,
+	descend:  true,
+}
+
+func (c *Chunker) chunkCSharp(tree *sitter.Tree) ([]Chunk, error) {
+	return c.chunkAST(tree, csharpSpec)
+}
+
+func extractCSharpNodeType(nodeType string) string {
+	switch nodeType {
+	case "namespace_declaration", "file_scoped_namespace_declaration":
+		return "namespace"
+	case "class_declaration":
+		return "class"
+	case "interface_declaration":
+		return "interface"
+	case "struct_declaration":
+		return "struct"
+	case "record_declaration":
+		return "record"
+	case "enum_declaration":
+		return "enum"
+	case "method_declaration":
+		return "method"
+	case "constructor_declaration":
+		return "constructor"
+	case "property_declaration":
+		return "property"
+	default:
+		return "code"
+	}
+}
@@ -0,0 +1,180 @@
+package chunker
+
+import (
+	"regexp"
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+)
+
+// Stylesheets split at rule sets and at-rules. Oversized @media blocks and
+// SCSS rules with nested rules descend into the rules they contain. SCSS is
+// parsed with the CSS grammar, where @mixin is a generic at_rule and @include
+// a postcss_statement.
+var cssSpec = astSpec{
+	targets: map[string]bool{
+		"rule_set":            true,
+		"at_rule":             true,
+		"media_statement":     true,
+		"supports_statement":  true,
+		"keyframes_statement": true,
+		"import_statement":    true,
+		"postcss_statement":   true,
+	},
+	typeName: extractCSSNodeType,
+	kindName: extractCSSAtKeyword,
+	nodeName: extractCSSNodeName,
+	descend:  true,
+}
+
+// styleImport matches the start of a Sass or LESS @use, @forward or @import
+// directive.
+var styleImport = regexp.MustCompile(`^@(?:use|forward|import)\b`)
+
+// chunkCSS chunks a stylesheet. The @use, @forward and @import directives a
+// SCSS or LESS file opens with form an "imports" chunk of their own, which
+// the grammar can't be relied on for: it reads `@use` as an error. The rules
+// below them are chunked without them.
+func (c *Chunker) chunkCSS(tree *sitter.Tree) ([]Chunk, error) {
+	c.styleImports = ""
+	if lang := c.parser.GetLanguage(); lang != "scss" && lang != "less" {
+		return c.chunkAST(tree, cssSpec)
+	}
+	end, directives := c.styleImportLines()
+	if end < 0 {
+		return c.chunkAST(tree, cssSpec)
+	}
+	c.styleImports = strings.Join(directives, "\n")
+
+	imports := c.splitLineRange(0, end, "imports", "")
+	for i := range imports {
+		imports[i].Context = c.truncateContext(strings.Join(strings.Fields(c.styleImports), " "))
+	}
+	// Chunking the rest is chunking a range of the file, within any range
+	// ChunkRange asked for
+	window := c.window
+	rest := lineWindow{from: end + 1, to: c.LineCount() - 1}
+	if window != nil {
+		rest.from, rest.to = max(rest.from, window.from), min(rest.to, window.to)
+	}
+	if rest.from > rest.to {
+		return imports, nil
+	}
+	c.window = &rest
+	chunks, err := c.chunkAST(tree, cssSpec)
+	c.window = window
+	if err != nil {
+		return nil, err
+	}
+	// A stylesheet that fits the budget is a single chunk of the whole
+	// file, which the window doesn't clip
+	for i := range chunks {
+		if chunks[i].StartLine <= end+1 {
+			chunks[i].StartLine = end + 2
+			chunks[i].Content = c.linesToString(end+1, chunks[i].EndLine-1)
+			chunks[i].Context = c.extractContext(chunks[i].Content)
+		}
+	}
+	return append(imports, chunks...), nil
+}
+
+// styleImportLines returns the last 0-based line of the @use, @forward and
+// @import directives a SCSS or LESS file opens with, or -1 if it opens with
+// none, and the directives themselves. Comments and blank lines may come
+// between them; a directive runs on to its semicolon.
+func (c *Chunker) styleImportLines() (int, []string) {
+	end := -1
+	var directives []string
+	inComment, inDirective := false, false
+	for i, line := range c.sourceLines[:c.LineCount()] {
+		trimmed := strings.TrimSpace(line)
+		switch {
+		case inComment:
+			inComment = !strings.Contains(trimmed, "*/")
+		case inDirective:
+			directives[len(directives)-1] += "\n" + line
+			end = i
+			inDirective = !strings.Contains(trimmed, ";")
+		case trimmed == "" || strings.HasPrefix(trimmed, "//"):
+		case strings.HasPrefix(trimmed, "/*"):
+			inComment = !strings.Contains(trimmed, "*/")
+		case styleImport.MatchString(trimmed):
+			directives = append(directives, line)
+			end = i
+			inDirective = !strings.Contains(trimmed, ";")
+		default:
+			return end, directives
+		}
+	}
+	return end, directives
+}
+
+// prependStyleImports prefixes the Content of every chunk but the imports
+// chunk with the file's module directives, for Options.PrependStyleImports.
+// Like a line marker, the prefix is recorded in Marker.
+func (c *Chunker) prependStyleImports(chunks []Chunk) {
+	for i := range chunks {
+		if chunks[i].Type == "imports" || chunks[i].EndLine == 0 {
+			continue
+		}
+		chunks[i].Marker = c.styleImports
+		chunks[i].Content = c.styleImports + "\n" + chunks[i].Content
+	}
+}
+
+func extractCSSNodeType(nodeType string) string {
+	switch nodeType {
+	case "rule_set":
+		return "rule"
+	case "media_statement":
+		return "media"
+	case "supports_statement":
+		return "supports"
+	case "keyframes_statement":
+		return "keyframes"
+	case "import_statement":
+		return "import"
+	case "at_rule", "postcss_statement":
+		return "at-rule"
+	default:
+		return "code"
+	}
+}
+
+// extractCSSAtKeyword labels generic at-rules by their keyword, so SCSS
+// `@mixin` and `@include` chunks read as "mixin" and "include". A LESS
+// variable such as `@primary: #336699;` also parses as an at-rule; it reads
+// as "variable".
+func extractCSSAtKeyword(node *sitter.Node, source string) string {
+	if node.Type() != "at_rule" && node.Type() != "postcss_statement" {
+		return ""
+	}
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		child := node.NamedChild(i)
+		if child.Type() == "at_keyword" {
+			if strings.HasPrefix(source[child.EndByte():], ":") {
+				return "variable"
+			}
+			return strings.TrimPrefix(source[child.StartByte():child.EndByte()], "@")
+		}
+	}
+	return ""
+}
+
+// extractCSSNodeName returns the selector or at-rule prelude: everything
+// before the block, collapsed onto one line.
+func extractCSSNodeName(node *sitter.Node, source string) string {
+	if node.Type() == "stylesheet" {
+		return ""
+	}
+	end := node.EndByte()
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		child := node.NamedChild(i)
+		if child.Type() == "block" || child.Type() == "keyframe_block_list" {
+			end = child.StartByte()
+			break
+		}
+	}
+	name := strings.Join(strings.Fields(source[node.StartByte():end]), " ")
+	return strings.TrimSuffix(name, ";")
+}
@@ -0,0 +1,205 @@
+package chunker
+
+import (
+	"regexp"
+	"strings"
+)
+
+// dartType matches the first line of a top-level Dart type declaration,
+// capturing its keyword and name. Class modifiers are skipped; an unnamed
+// extension has an empty name.
+var dartType = regexp.MustCompile(`^(?:(?:abstract|sealed|base|final|interface|mixin)\s+)*(class|mixin|enum|extension|typedef)\b\s*(\w*)`)
+
+// dartVariable matches a top-level or member variable declaration, capturing
+// its name.
+var dartVariable = regexp.MustCompile(`^\s*(?:(?:static|late|final|const|var|external)\s+)+(?:[\w<>?,. \[\]]+\s+)?(\w+)\s*(?:=|;)`)
+
+// dartField matches a member variable declared without modifiers, such as
+// `String title;`, capturing its name.
+var dartField = regexp.MustCompile(`(\w+)\s*[;=]`)
+
+// dartFunction matches a function, method, getter, setter, operator or
+// constructor header, capturing its name. Return types, type parameters and
+// modifiers before the name are skipped.
+var dartFunction = regexp.MustCompile(`^\s*(?:(?:static|external|factory|const|abstract)\s+)*(?:[\w<>?,. \[\]]+\s+)?(?:(get|set)\s+(\w+)|operator\s*(\S+?)\s*\(|(\w+(?:\.\w+)?)\s*(?:<[^>]*>)?\s*\()`)
+
+// chunkDart splits a Dart file at its top-level declarations: classes,
+// mixins, enums, extensions, typedefs and functions, with runs of top-level
+// variables sharing a chunk. There is no tree-sitter grammar for Dart, so
+// declarations are found by line: they start unindented, and the comments
+// and annotations directly above one belong to it. A class, mixin or
+// extension too large for maxTokens is split at its members, so a long
+// Flutter build method gets a chunk of its own.
+func (c *Chunker) chunkDart() ([]Chunk, error) {
+	var decls []lineSection
+	lead := -1 // first line of the comments or annotations above the next declaration
+	inComment := false
+	for i, line := range c.sourceLines {
+		trimmed := strings.TrimSpace(line)
+		switch {
+		case inComment:
+			inComment = !strings.Contains(trimmed, "*/")
+		case trimmed == "" || line[0] == ' ' || line[0] == '\t' || line[0] == '}' || line[0] == ')':
+			lead = -1
+		case strings.HasPrefix(trimmed, "/*"):
+			if lead < 0 {
+				lead = i
+			}
+			inComment = !strings.Contains(trimmed, "*/")
+		case strings.HasPrefix(trimmed, "//") || strings.HasPrefix(trimmed, "@"):
+			if lead < 0 {
+				lead = i
+			}
+		case isBoilerplate("dart", trimmed):
+			lead = -1
+		default:
+			kind, name := dartDeclaration(line)
+			if kind == "" {
+				lead = -1
+				continue
+			}
+			start := i
+			if lead >= 0 {
+				start = lead
+			}
+			lead = -1
+			if prev := len(decls) - 1; kind == "variable" && prev >= 0 && decls[prev].kind == "variable" && start == i {
+				continue
+			}
+			decls = append(decls, lineSection{start: start, kind: kind, name: name})
+		}
+	}
+	if len(decls) == 0 {
+		return c.chunkFallback()
+	}
+
+	var chunks []Chunk
+	if preamble := c.linesToString(0, decls[0].start-1); strings.TrimSpace(preamble) != "" {
+		chunks = append(chunks, c.splitLineRange(0, decls[0].start-1, "preamble", "")...)
+	}
+	for i, d := range decls {
+		end := c.LineCount() - 1
+		if i+1 < len(decls) {
+			end = decls[i+1].start - 1
+		}
+		if (d.kind == "class" || d.kind == "mixin" || d.kind == "extension") &&
+			c.linesTokens(d.start, end) > c.maxTokens {
+			chunks = append(chunks, c.chunkDartMembers(d, end)...)
+			continue
+		}
+		chunks = append(chunks, c.splitLineRange(d.start, end, d.kind, d.name)...)
+	}
+	return chunks, nil
+}
+
+// dartDeclaration returns the chunk type and name of the top-level
+// declaration starting on line, or "" if line does not start one.
+func dartDeclaration(line string) (kind, name string) {
+	if m := dartType.FindStringSubmatch(line); m != nil {
+		return m[1], m[2]
+	}
+	if m := dartVariable.FindStringSubmatch(line); m != nil {
+		return "variable", m[1]
+	}
+	if m := dartFunction.FindStringSubmatch(line); m != nil {
+		return "function", m[2] + m[3] + m[4]
+	}
+	return "", ""
+}
+
+// chunkDartMembers splits the type declaration d, which ends on line end, at
+// its members. The lines up to the first member form a chunk of d's type;
+// each member is chunked on its own, or with its neighbours for runs of
+// fields, with d as its parent. Members are the declarations at the type's
+// first indentation level; lines at that level that close a declaration
+// (`}`, `)`) stay with the member before them.
+func (c *Chunker) chunkDartMembers(d lineSection, end int) []Chunk {
+	var members []lineSection
+	indent := ""
+	lead := -1
+	inComment := false
+	for i := d.start + 1; i < end; i++ {
+		line := c.sourceLines[i]
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" {
+			lead = -1
+			continue
+		}
+		if indent == "" {
+			indent = line[:len(line)-len(strings.TrimLeft(line, " \t"))]
+			if indent == "" {
+				break
+			}
+		}
+		switch {
+		case inComment:
+			inComment = !strings.Contains(trimmed, "*/")
+		case !strings.HasPrefix(line, indent) || len(line) > len(indent) && (line[len(indent)] == ' ' || line[len(indent)] == '\t'):
+			lead = -1
+		case strings.HasPrefix(trimmed, "/*"):
+			if lead < 0 {
+				lead = i
+			}
+			inComment = !strings.Contains(trimmed, "*/")
+		case strings.HasPrefix(trimmed, "//") || strings.HasPrefix(trimmed, "@"):
+			if lead < 0 {
+				lead = i
+			}
+		case strings.HasPrefix(trimmed, "}") || strings.HasPrefix(trimmed, ")") || strings.HasPrefix(trimmed, "]"):
+			lead = -1
+		default:
+			kind, name := dartMember(trimmed, d.name)
+			start := i
+			if lead >= 0 {
+				start = lead
+			}
+			lead = -1
+			if prev := len(members) - 1; kind == "field" && prev >= 0 && members[prev].kind == "field" && start == i {
+				continue
+			}
+			members = append(members, lineSection{start: start, kind: kind, name: name})
+		}
+	}
+	if len(members) == 0 {
+		return c.splitLineRange(d.start, end, d.kind, d.name)
+	}
+
+	chunks := c.splitLineRange(d.start, members[0].start-1, d.kind, d.name)
+	for i, m := range members {
+		last := end
+		if i+1 < len(members) {
+			last = members[i+1].start - 1
+		}
+		pieces := c.splitLineRange(m.start, last, m.kind, m.name)
+		for j := range pieces {
+			pieces[j].Parent = d.name
+		}
+		chunks = append(chunks, pieces...)
+	}
+	return chunks
+}
+
+// dartMember returns the chunk type and name of the class member declared
+// on the trimmed line. Constructors are recognised by the class name.
+func dartMember(trimmed, className string) (kind, name string) {
+	if m := dartVariable.FindStringSubmatch(trimmed); m != nil {
+		return "field", m[1]
+	}
+	if m := dartFunction.FindStringSubmatch(trimmed); m != nil {
+		switch {
+		case m[1] == "get":
+			return "getter", m[2]
+		case m[1] == "set":
+			return "setter", m[2]
+		case m[3] != "":
+			return "operator", m[3]
+		case m[4] == className || strings.HasPrefix(m[4], className+"."):
+			return "constructor", m[4]
+		}
+		return "method", m[4]
+	}
+	if m := dartField.FindStringSubmatch(trimmed); m != nil {
+		return "field", m[1]
+	}
+	return "member", ""
+}
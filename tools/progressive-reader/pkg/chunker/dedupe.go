@@ -0,0 +1,23 @@
+package chunker
+
+// dedupeChunks drops every chunk whose Hash matches an earlier one, adding
+// its lines to the earlier chunk's Duplicates, and renumbers the chunks
+// that remain. Chunks must already be finalized.
+func dedupeChunks(chunks []Chunk) []Chunk {
+	first := make(map[string]int)
+	kept := chunks[:0]
+	for _, ch := range chunks {
+		if ch.Content == "" {
+			kept = append(kept, ch)
+			continue
+		}
+		if i, ok := first[ch.Hash]; ok {
+			kept[i].Duplicates = append(kept[i].Duplicates, LineRange{ch.StartLine, ch.EndLine})
+			continue
+		}
+		first[ch.Hash] = len(kept)
+		kept = append(kept, ch)
+	}
+	numberChunks(kept)
+	return kept
+}
@@ -0,0 +1,50 @@
+package chunker
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDedupe(t *testing.T) {
+	// The second and third functions are identical chunks, leading blank
+	// line included; in commented, once the third's comment is stripped
+	source := "package p\n\nfunc f() int {\n\treturn 1\n}\n\nfunc f() int {\n\treturn 1\n}\n\nfunc f() int {\n\treturn 1\n}\n"
+	commented := "package p\n\nfunc f() int {\n\treturn 1\n}\n\nfunc f() int {\n\treturn 1\n}\n\nfunc f() int {\n\t// one\n\treturn 1\n}\n"
+	tests := []struct {
+		name       string
+		source     string
+		opts       Options
+		starts     []int               // StartLine of each chunk
+		duplicates map[int][]LineRange // Duplicates by StartLine
+	}{
+		{"off", source, Options{}, []int{1, 6, 10}, map[int][]LineRange{}},
+		{"on", source, Options{Dedupe: true}, []int{1, 6}, map[int][]LineRange{6: {{10, 13}}}},
+		{"longer copy", commented, Options{Dedupe: true, StripComments: true}, []int{1, 6}, map[int][]LineRange{6: {{10, 14}}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.opts.MaxTokens = 10
+			chunks := chunkSource(t, "p.go", tt.source, tt.opts)
+			if got := startLines(chunks); !reflect.DeepEqual(got, tt.starts) {
+				t.Errorf("chunks start on lines %v, want %v", got, tt.starts)
+			}
+			ids := map[string]bool{}
+			got := map[int][]LineRange{}
+			for i, ch := range chunks {
+				if ch.CurrentChunk != i || ch.TotalChunks != len(chunks) {
+					t.Errorf("chunk on line %d numbered %d/%d, want %d/%d", ch.StartLine, ch.CurrentChunk, ch.TotalChunks, i, len(chunks))
+				}
+				if ids[ch.StableID] {
+					t.Errorf("chunk on line %d repeats StableID %s", ch.StartLine, ch.StableID)
+				}
+				ids[ch.StableID] = true
+				if len(ch.Duplicates) > 0 {
+					got[ch.StartLine] = ch.Duplicates
+				}
+			}
+			if !reflect.DeepEqual(got, tt.duplicates) {
+				t.Errorf("Duplicates = %v, want %v", got, tt.duplicates)
+			}
+		})
+	}
+}
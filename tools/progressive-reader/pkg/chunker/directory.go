@@ -0,0 +1,96 @@
+package chunker
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/arpitnath/super-claude-kit/tools/progressive-reader/pkg/parser"
+)
+
+// FileChunks pairs a file with its chunks, or with the error that stopped it
+// from being chunked.
+type FileChunks struct {
+	Path   string
+	Chunks []Chunk
+	Err    error
+}
+
+// ChunkDirectory chunks every text file under root, skipping binary files,
+// DefaultIgnorePatterns and anything excluded by root's .gitignore.
+func ChunkDirectory(root string, maxTokens int) ([]FileChunks, error) {
+	patterns := DefaultIgnorePatterns
+	if data, err := os.ReadFile(filepath.Join(root, ".gitignore")); err == nil {
+		patterns = append(append([]string{}, patterns...), strings.Split(string(data), "\n")...)
+	}
+
+	ignore, err := NewIgnoreMatcher(patterns)
+	if err != nil {
+		return nil, err
+	}
+	return WalkDirectory(root, Options{MaxTokens: maxTokens}, ignore)
+}
+
+// WalkDirectory chunks every text file under root with opts, skipping paths
+// matched by ignore (which may be nil). Failures on individual files are
+// reported in their FileChunks entry and don't stop the walk; the returned
+// error is only set when root itself can't be walked.
+func WalkDirectory(root string, opts Options, ignore *IgnoreMatcher) ([]FileChunks, error) {
+	pool := parser.NewPool()
+	var results []FileChunks
+
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if path == root {
+				return err
+			}
+			results = append(results, FileChunks{Path: path, Err: err})
+			return nil
+		}
+
+		rel, relErr := filepath.Rel(root, path)
+		if relErr != nil || rel == "." {
+			return nil
+		}
+		if ignore != nil && ignore.Match(rel, d.IsDir()) {
+			if d.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+
+		content, err := os.ReadFile(path)
+		if err != nil {
+			results = append(results, FileChunks{Path: path, Err: err})
+			return nil
+		}
+
+		chunks, err := chunkWithPool(pool, path, content, opts)
+		if errors.Is(err, ErrBinaryFile) {
+			return nil
+		}
+		results = append(results, FileChunks{Path: path, Chunks: chunks, Err: err})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+func chunkWithPool(pool *parser.Pool, path string, content []byte, opts Options) ([]Chunk, error) {
+	p, err := pool.ForContent(path, content)
+	if err != nil {
+		return nil, err
+	}
+	c, err := NewChunkerWithParser(p, path, content, opts)
+	if err != nil {
+		return nil, err
+	}
+	return c.ChunkFile()
+}
@@ -0,0 +1,42 @@
+package chunker
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestWalkDirectoryChunksTSXAfterJSX(t *testing.T) {
+	root := t.TempDir()
+	files := map[string]string{
+		"a.jsx": "function App() {\n  return <div />\n}\n",
+		"b.tsx": "interface Foo {\n  x: number\n}\n\ntype Bar = string\n\nfunction f() {\n  return <span />\n}\n",
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(root, name), []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	results, err := WalkDirectory(root, Options{MaxTokens: 5}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	names := map[string]bool{}
+	for _, r := range results {
+		if r.Err != nil {
+			t.Fatalf("%s: %v", r.Path, r.Err)
+		}
+		if filepath.Base(r.Path) != "b.tsx" {
+			continue
+		}
+		for _, ch := range r.Chunks {
+			names[ch.Name] = true
+		}
+	}
+	for _, want := range []string{"Foo", "Bar", "f"} {
+		if !names[want] {
+			t.Errorf("b.tsx has no chunk named %q after a.jsx was chunked; got %v", want, names)
+		}
+	}
+}
@@ -0,0 +1,38 @@
+package chunker
+
+import (
+	"regexp"
+	"strings"
+)
+
+// dockerFrom matches a FROM instruction, capturing the base image and the
+// optional stage alias. Flags such as --platform are skipped.
+var dockerFrom = regexp.MustCompile(`(?i)^\s*FROM\s+(?:--\S+\s+)*(\S+)(?:\s+AS\s+(\S+))?`)
+
+// chunkDockerfile splits a Dockerfile into its build stages, one per FROM
+// instruction. Each stage is named after its alias, or its base image when
+// it has none, and takes the comments directly above its FROM. Lines before
+// the first stage (global ARGs, parser directives) form a "preamble" chunk.
+// Stages too large for maxTokens are split by lines.
+func (c *Chunker) chunkDockerfile() ([]Chunk, error) {
+	var stages []lineSection
+	for i, line := range c.sourceLines {
+		if m := dockerFrom.FindStringSubmatch(line); m != nil {
+			name := m[1]
+			if m[2] != "" {
+				name = m[2]
+			}
+			// A comment block directly above FROM describes the stage
+			start := i
+			for start > 0 && strings.HasPrefix(strings.TrimSpace(c.sourceLines[start-1]), "#") &&
+				(len(stages) == 0 || start-1 > stages[len(stages)-1].start) {
+				start--
+			}
+			stages = append(stages, lineSection{start: start, kind: "stage", name: name})
+		}
+	}
+	if len(stages) == 0 {
+		return c.chunkFallback()
+	}
+	return c.chunkSections(stages), nil
+}
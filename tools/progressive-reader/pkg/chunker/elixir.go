@@ -0,0 +1,84 @@
+package chunker
+
+import (
+	sitter "github.com/smacker/go-tree-sitter"
+)
+
+// tree-sitter-elixir has no declaration nodes: `def foo(x) do ... end` is a
+// call to `def` whose first argument is the function head.
+var elixirSpec = astSpec{
+	targets: map[string]bool{
+		"call": true,
+	},
+	typeName: extractElixirNodeType,
+	nodeName: extractElixirNodeName,
+	kindName: extractElixirDefinitionKind,
+	match:    isElixirDefinition,
+	descend:  true,
+}
+
+func (c *Chunker) chunkElixir(tree *sitter.Tree) ([]Chunk, error) {
+	return c.chunkAST(tree, elixirSpec)
+}
+
+func extractElixirNodeType(nodeType string) string {
+	return "code"
+}
+
+// elixirDefinitionKinds maps the macros that define modules and functions to
+// chunk types.
+var elixirDefinitionKinds = map[string]string{
+	"defmodule": "module",
+	"def":       "function",
+	"defp":      "private_function",
+	"defmacro":  "macro",
+}
+
+// elixirCallee returns the name of the macro or function a call invokes, or
+// "" for remote calls such as `Repo.get(...)`.
+func elixirCallee(node *sitter.Node, source string) string {
+	target := node.ChildByFieldName("target")
+	if target == nil || target.Type() != "identifier" {
+		return ""
+	}
+	return source[target.StartByte():target.EndByte()]
+}
+
+func isElixirDefinition(node *sitter.Node, source string) bool {
+	_, ok := elixirDefinitionKinds[elixirCallee(node, source)]
+	return ok
+}
+
+func extractElixirDefinitionKind(node *sitter.Node, source string) string {
+	return elixirDefinitionKinds[elixirCallee(node, source)]
+}
+
+// extractElixirNodeName reads the first argument of a definition: the module
+// alias for defmodule, or the function head for def, defp and defmacro, which
+// may carry a guard (`foo(x) when is_binary(x)`) or omit its parentheses.
+func extractElixirNodeName(node *sitter.Node, source string) string {
+	var args *sitter.Node
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		if child := node.NamedChild(i); child.Type() == "arguments" {
+			args = child
+			break
+		}
+	}
+	if args == nil || args.NamedChildCount() == 0 {
+		return ""
+	}
+
+	head := args.NamedChild(0)
+	if head.Type() == "binary_operator" {
+		if left := head.ChildByFieldName("left"); left != nil {
+			head = left
+		}
+	}
+	switch head.Type() {
+	case "alias", "identifier":
+		return source[head.StartByte():head.EndByte()]
+	case "call":
+		return elixirCallee(head, source)
+	}
+	return ""
+}
@@ -0,0 +1,57 @@
+package chunker
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/arpitnath/super-claude-kit/tools/progressive-reader/pkg/parser"
+)
+
+// Errors returned by the chunker. They arrive wrapped in a *FileError, so
+// check for them with errors.Is.
+var (
+	// ErrUnsupportedLanguage means no grammar exists for the file's language;
+	// callers may want to retry the file as plain text.
+	ErrUnsupportedLanguage = parser.ErrUnsupportedLanguage
+	// ErrParseFailed means tree-sitter could not produce a syntax tree.
+	ErrParseFailed = parser.ErrParseFailed
+	// ErrBinaryFile means the content isn't text, so batch callers can tell
+	// "not text" apart from "couldn't parse".
+	ErrBinaryFile = errors.New("binary file")
+	// ErrInvalidRange means ChunkRange was given lines that don't form a
+	// range within the file.
+	ErrInvalidRange = errors.New("invalid line range")
+	// ErrInvalidEdit means Update was given an edit whose byte offsets fall
+	// outside the old or new source.
+	ErrInvalidEdit = errors.New("invalid edit")
+	// ErrInvalidOptions means the Options passed to a constructor contradict
+	// each other, such as ReservedTokens taking up all of MaxTokens.
+	ErrInvalidOptions = errors.New("invalid options")
+	// ErrInvalidChunks is returned by ValidateChunks, which wraps it with the
+	// problems found rather than in a *FileError.
+	ErrInvalidChunks = errors.New("invalid chunks")
+)
+
+// FileError reports which file, and which language it was detected as, a
+// chunking failure belongs to; Path is empty for content given by
+// ChunkString. Use errors.As to get at it.
+type FileError struct {
+	Path     string
+	Language string
+	Err      error
+}
+
+func newFileError(path, language string, err error) *FileError {
+	return &FileError{Path: path, Language: language, Err: err}
+}
+
+func (e *FileError) Error() string {
+	if e.Path == "" {
+		return fmt.Sprintf("(%s): %v", e.Language, e.Err)
+	}
+	return fmt.Sprintf("%s (%s): %v", e.Path, e.Language, e.Err)
+}
+
+func (e *FileError) Unwrap() error {
+	return e.Err
+}
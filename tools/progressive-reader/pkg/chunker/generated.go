@@ -0,0 +1,75 @@
+package chunker
+
+import (
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// lockfileNames are dependency lockfiles that are machine-written and rarely
+// worth reading.
+var lockfileNames = map[string]bool{
+	"package-lock.json":   true,
+	"npm-shrinkwrap.json": true,
+	"yarn.lock":           true,
+	"pnpm-lock.yaml":      true,
+	"go.sum":              true,
+	"Cargo.lock":          true,
+	"Gemfile.lock":        true,
+	"composer.lock":       true,
+	"poetry.lock":         true,
+	"Pipfile.lock":        true,
+}
+
+// generatedSuffixes are file name suffixes produced by code generators.
+var generatedSuffixes = []string{
+	".pb.go",
+	".pb.gw.go",
+	"_pb2.py",
+	"_pb2_grpc.py",
+	".pb.ts",
+	"_generated.go",
+}
+
+// generatedMarker matches the standard Go header (https://go.dev/s/generatedcode),
+// regardless of which comment syntax precedes it.
+var generatedMarker = regexp.MustCompile(`^\W*Code generated .* DO NOT EDIT\.$`)
+
+// generatedHeaderLines is how many leading lines are checked for the marker.
+const generatedHeaderLines = 10
+
+// detectGenerated returns why a file looks machine-generated, or "" if it
+// doesn't.
+func detectGenerated(filePath string, lines []string) string {
+	base := filepath.Base(filePath)
+	if lockfileNames[base] {
+		return "lockfile"
+	}
+	for _, suffix := range generatedSuffixes {
+		if strings.HasSuffix(base, suffix) {
+			return "generated file (" + suffix + ")"
+		}
+	}
+
+	for i := 0; i < len(lines) && i < generatedHeaderLines; i++ {
+		trimmed := strings.TrimSpace(lines[i])
+		if generatedMarker.MatchString(trimmed) {
+			return trimmed
+		}
+	}
+	return ""
+}
+
+// chunkGenerated returns a single content-free chunk spanning the whole file,
+// so callers still see the file without spending tokens on it.
+func (c *Chunker) chunkGenerated(reason string) []Chunk {
+	chunks := []Chunk{{
+		StartLine: 1,
+		EndLine:   c.LineCount(),
+		Type:      "generated",
+		Name:      filepath.Base(c.filePath),
+		Context:   reason,
+	}}
+	c.finalizeChunks(chunks)
+	return chunks
+}
@@ -0,0 +1,53 @@
+package chunker
+
+import (
+	"regexp"
+	"strings"
+)
+
+// graphQLDefinition matches the first line of a top-level GraphQL
+// definition, capturing its keyword and name. `extend type Query` is typed
+// like the type it extends.
+var graphQLDefinition = regexp.MustCompile(`^(?:extend\s+)?(type|input|enum|interface|scalar|union|schema|directive|fragment|query|mutation|subscription)\b\s*@?(\w*)`)
+
+// chunkGraphQL splits a GraphQL document at its top-level definitions, one
+// chunk per type, input, enum, interface, scalar, union, schema, directive
+// or operation, named after the definition. There is no tree-sitter grammar
+// for GraphQL, so definitions are found by line: they start unindented, and
+// the description string or comments directly above one belong to it.
+func (c *Chunker) chunkGraphQL() ([]Chunk, error) {
+	var defs []lineSection
+	lead := -1 // first line of the description or comments above the next definition
+	inString := false
+	for i, line := range c.sourceLines {
+		trimmed := strings.TrimSpace(line)
+		switch {
+		case inString:
+			inString = !strings.Contains(trimmed, `"""`)
+		case trimmed == "" || line[0] == ' ' || line[0] == '\t':
+			lead = -1
+		case strings.HasPrefix(trimmed, `"""`):
+			if lead < 0 {
+				lead = i
+			}
+			inString = strings.Count(trimmed, `"""`) == 1
+		case strings.HasPrefix(trimmed, `"`) || strings.HasPrefix(trimmed, "#"):
+			if lead < 0 {
+				lead = i
+			}
+		default:
+			if m := graphQLDefinition.FindStringSubmatch(line); m != nil {
+				start := i
+				if lead >= 0 {
+					start = lead
+				}
+				defs = append(defs, lineSection{start: start, kind: m[1], name: m[2]})
+			}
+			lead = -1
+		}
+	}
+	if len(defs) == 0 {
+		return c.chunkFallback()
+	}
+	return c.chunkSections(defs), nil
+}
@@ -0,0 +1,135 @@
+package chunker
+
+import (
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+)
+
+// goDecl is a top-level Go declaration's 0-based lines and, for a method,
+// its receiver type.
+type goDecl struct {
+	start, end int
+	receiver   string
+}
+
+// goLayout is what Options.GroupMethods needs to know of a Go file's
+// declarations, taken from its syntax tree by chunkGo.
+type goLayout struct {
+	decls     []goDecl
+	typeLines map[string]int // type name to the 0-based line declaring it
+}
+
+// goDeclarations returns the layout of the top-level declarations under root.
+func (c *Chunker) goDeclarations(root *sitter.Node) *goLayout {
+	source := string(c.sourceCode)
+	typeLines := make(map[string]int)
+	var decls []goDecl
+	for i := 0; i < int(root.NamedChildCount()); i++ {
+		node := root.NamedChild(i)
+		start, end := c.nodeLines(node)
+		switch node.Type() {
+		case "comment":
+			continue
+		case "type_declaration":
+			for j := 0; j < int(node.NamedChildCount()); j++ {
+				if name := extractFieldName(node.NamedChild(j), source); name != "" {
+					typeLines[name] = int(node.NamedChild(j).StartPoint().Row)
+				}
+			}
+		}
+		receiver := ""
+		if node.Type() == "method_declaration" {
+			receiver = extractGoReceiverType(node, source)
+		}
+		decls = append(decls, goDecl{start: start, end: end, receiver: receiver})
+	}
+	return &goLayout{decls: decls, typeLines: typeLines}
+}
+
+// groupMethods implements Options.GroupMethods for a Go file: every chunk
+// holding nothing but methods of one type is folded into the chunk declaring
+// that type, in line order, as long as the result fits the token budget and
+// the MaxLines and MaxBytes caps. The type's chunk keeps its own lines and
+// records each folded chunk's in Grouped. It runs after the passes that cut
+// chunks at source lines and bytes, as a grouped chunk's lines aren't
+// contiguous; pieces of a split long line are left alone.
+func (c *Chunker) groupMethods(chunks []Chunk) []Chunk {
+	removed := make([]bool, len(chunks))
+	for i, ch := range chunks {
+		if ch.EndByte != 0 {
+			continue
+		}
+		receiver := methodsOf(c.goLayout.decls, ch.StartLine-1, ch.EndLine-1)
+		line, ok := c.goLayout.typeLines[receiver]
+		if !ok {
+			continue
+		}
+		t := chunkAt(chunks, line+1)
+		if t < 0 || t == i || removed[t] || chunks[t].EndByte != 0 {
+			continue
+		}
+		content := chunks[t].Content + "\n" + ch.Content
+		if c.estimateTokens(content) > c.maxTokens ||
+			c.opts.MaxLines > 0 && strings.Count(content, "\n")+1 > c.opts.MaxLines ||
+			c.opts.MaxBytes > 0 && len(content) > c.opts.MaxBytes {
+			continue
+		}
+		chunks[t].Content = content
+		chunks[t].Grouped = append(chunks[t].Grouped, LineRange{Start: ch.StartLine, End: ch.EndLine})
+		chunks[t].References = appendMissing(chunks[t].References, ch.References)
+		removed[i] = true
+	}
+
+	kept := chunks[:0]
+	for i, ch := range chunks {
+		if !removed[i] {
+			kept = append(kept, ch)
+		}
+	}
+	return kept
+}
+
+// methodsOf returns the receiver type shared by the declarations within the
+// 0-based lines start..end if all of them are methods of it, and "" if any
+// is not or one is only partly within them.
+func methodsOf(decls []goDecl, start, end int) string {
+	receiver := ""
+	for _, d := range decls {
+		if d.end < start || d.start > end {
+			continue
+		}
+		if d.start < start || d.end > end || d.receiver == "" || receiver != "" && d.receiver != receiver {
+			return ""
+		}
+		receiver = d.receiver
+	}
+	return receiver
+}
+
+// chunkAt returns the index of the chunk covering the 1-based line, or -1.
+func chunkAt(chunks []Chunk, line int) int {
+	for i, ch := range chunks {
+		if ch.StartLine <= line && line <= ch.EndLine {
+			return i
+		}
+	}
+	return -1
+}
+
+// appendMissing appends the items of add not already in list.
+func appendMissing(list, add []string) []string {
+	for _, s := range add {
+		found := false
+		for _, have := range list {
+			if have == s {
+				found = true
+				break
+			}
+		}
+		if !found {
+			list = append(list, s)
+		}
+	}
+	return list
+}
@@ -0,0 +1,77 @@
+package chunker
+
+import (
+	"strings"
+	"testing"
+)
+
+const groupSource = `package repo
+
+// Repo stores things.
+type Repo struct {
+	n int
+}
+
+func helper() int {
+	return 1
+}
+
+func (r *Repo) Get() int {
+	return r.n
+}
+
+func (r *Repo) Set(n int) {
+	r.n = n
+}
+`
+
+func TestGroupMethods(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    Options
+		grouped []LineRange // Grouped of the chunk on line 1
+		marker  string      // first line of the chunk on line 1
+	}{
+		{"off", Options{MaxTokens: 60}, nil, ""},
+		{"grouped", Options{MaxTokens: 60, GroupMethods: true}, []LineRange{{11, 14}, {15, 18}}, ""},
+		{"line markers", Options{MaxTokens: 60, GroupMethods: true, LineMarkers: true}, []LineRange{{11, 14}, {15, 18}}, "// chunk 1/2 lines 1-6, 11-14, 15-18"},
+		{"over MaxLines", Options{MaxTokens: 60, GroupMethods: true, MaxLines: 4}, nil, ""},
+		{"over MaxBytes", Options{MaxTokens: 60, GroupMethods: true, MaxBytes: 60}, nil, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.opts.OneChunkPerSymbol = true
+			c, err := NewChunkerWithOptions("repo.go", []byte(groupSource), tt.opts)
+			if err != nil {
+				t.Fatal(err)
+			}
+			chunks, err := c.ChunkFile()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := ValidateChunks(chunks, c.LineCount()); err != nil {
+				t.Fatal(err)
+			}
+			first := chunks[0]
+			if len(first.Grouped) != len(tt.grouped) {
+				t.Fatalf("Grouped = %v, want %v", first.Grouped, tt.grouped)
+			}
+			for i := range tt.grouped {
+				if first.Grouped[i] != tt.grouped[i] {
+					t.Fatalf("Grouped = %v, want %v", first.Grouped, tt.grouped)
+				}
+			}
+			if tt.marker != "" && !strings.HasPrefix(first.Content, tt.marker+"\n") {
+				t.Errorf("content starts %q, want marker %q", strings.SplitN(first.Content, "\n", 2)[0], tt.marker)
+			}
+			for _, ch := range chunks {
+				if tt.opts.MaxLines > 0 && strings.Count(ch.Content, "\n")+1 > tt.opts.MaxLines {
+					t.Errorf("chunk %d-%d holds more than %d lines", ch.StartLine, ch.EndLine, tt.opts.MaxLines)
+				}
+				if tt.opts.MaxBytes > 0 && len(ch.Content) > tt.opts.MaxBytes {
+					t.Errorf("chunk %d-%d holds more than %d bytes", ch.StartLine, ch.EndLine, tt.opts.MaxBytes)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,113 @@
+package chunker
+
+import (
+	"regexp"
+	"strings"
+)
+
+// haskellType matches the first line of a top-level type, class or instance
+// declaration, capturing its keyword and the rest of the line.
+var haskellType = regexp.MustCompile(`^(data|newtype|type family|type|class|instance)\s+(.*)`)
+
+// haskellBinding matches the start of a type signature or equation, capturing
+// the name it binds: an identifier or a parenthesized operator.
+var haskellBinding = regexp.MustCompile(`^(\([^)\s]+\)|[a-z_][\w']*)`)
+
+// haskellInfix matches an equation defining an operator or backticked
+// function infix, e.g. "a <+> b = ..." or "x `divides` y = ...", capturing
+// the name.
+var haskellInfix = regexp.MustCompile("^[a-z_][\\w']*\\s+(?:`([\\w']+)`|([!#$%&*+./<>?@\\\\^|~:=-]+))\\s")
+
+// haskellKinds maps declaration keywords to chunk types.
+var haskellKinds = map[string]string{
+	"data":        "data",
+	"newtype":     "newtype",
+	"type":        "type",
+	"type family": "type_family",
+	"class":       "class",
+	"instance":    "instance",
+}
+
+// chunkHaskell splits a Haskell module at its top-level declarations: data
+// and newtype declarations, type synonyms and families, classes, instances
+// and functions. A function's type signature and its equations are separate
+// lines but one declaration, so consecutive bindings of the same name share
+// a chunk. There is no tree-sitter grammar for Haskell, so declarations are
+// found by line: they start unindented, and the comments and pragmas
+// directly above one belong to it. The module header and imports form the
+// preamble.
+func (c *Chunker) chunkHaskell() ([]Chunk, error) {
+	var decls []lineSection
+	lead := -1 // first line of the comments above the next declaration
+	inComment := false
+	for i, line := range c.sourceLines {
+		trimmed := strings.TrimSpace(line)
+		switch {
+		case inComment:
+			inComment = !strings.Contains(trimmed, "-}")
+		case trimmed == "" || line[0] == ' ' || line[0] == '\t':
+			lead = -1
+		case strings.HasPrefix(trimmed, "{-"):
+			if lead < 0 {
+				lead = i
+			}
+			inComment = !strings.Contains(trimmed, "-}")
+		case strings.HasPrefix(trimmed, "--"):
+			if lead < 0 {
+				lead = i
+			}
+		case isBoilerplate("haskell", trimmed):
+			lead = -1
+		default:
+			kind, name := haskellDeclaration(line)
+			start := i
+			if lead >= 0 {
+				start = lead
+			}
+			lead = -1
+			if kind == "" {
+				continue
+			}
+			// A signature and its equations, or successive equations
+			if prev := len(decls) - 1; kind == "function" && prev >= 0 && decls[prev].kind == "function" &&
+				decls[prev].name == name && start == i {
+				continue
+			}
+			decls = append(decls, lineSection{start: start, kind: kind, name: name})
+		}
+	}
+	if len(decls) == 0 {
+		return c.chunkFallback()
+	}
+	return c.chunkSections(decls), nil
+}
+
+// haskellDeclaration returns the chunk type and name of the top-level
+// declaration starting on line, or "" if line does not start one. Types
+// and classes are named after their type constructor; instances after the
+// class and type they join, e.g. "Show Color".
+func haskellDeclaration(line string) (kind, name string) {
+	if m := haskellType.FindStringSubmatch(line); m != nil {
+		head := strings.TrimSpace(strings.SplitN(m[2], " where", 2)[0])
+		head = strings.TrimSpace(strings.SplitN(head, "=", 2)[0])
+		// Drop a context such as `(Eq a, Show a) =>`
+		if i := strings.Index(head, "=>"); i >= 0 {
+			head = strings.TrimSpace(head[i+2:])
+		}
+		if m[1] == "instance" {
+			return haskellKinds[m[1]], strings.Join(strings.Fields(head), " ")
+		}
+		fields := strings.Fields(head)
+		if len(fields) == 0 {
+			return haskellKinds[m[1]], ""
+		}
+		return haskellKinds[m[1]], fields[0]
+	}
+	if m := haskellInfix.FindStringSubmatch(line); m != nil && m[2] != "=" && m[2] != "::" && m[2] != "|" {
+		return "function", m[1] + m[2]
+	}
+	if m := haskellBinding.FindStringSubmatch(line); m != nil {
+		return "function", strings.Trim(m[1], "()")
+	}
+	return "", ""
+}
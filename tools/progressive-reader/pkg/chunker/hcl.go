@@ -0,0 +1,63 @@
+package chunker
+
+import (
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+)
+
+// hclSpec chunks Terraform and other HCL files by top-level block. Nested
+// blocks (a resource's `tags`, a data source's `filter`) stay with their
+// parent, and an oversized block is split by lines.
+var hclSpec = astSpec{
+	targets: map[string]bool{
+		"block": true,
+	},
+	typeName: extractHCLNodeType,
+	nodeName: extractHCLNodeName,
+	kindName: extractHCLBlockType,
+}
+
+func (c *Chunker) chunkHCL(tree *sitter.Tree) ([]Chunk, error) {
+	return c.chunkAST(tree, hclSpec)
+}
+
+func extractHCLNodeType(nodeType string) string {
+	if nodeType == "block" {
+		return "block"
+	}
+	return "code"
+}
+
+// hclBlockParts returns a block's type followed by its labels, e.g.
+// ["resource", "aws_instance", "web"], with label quotes removed.
+func hclBlockParts(node *sitter.Node, source string) []string {
+	var parts []string
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		child := node.NamedChild(i)
+		switch child.Type() {
+		case "identifier":
+			parts = append(parts, source[child.StartByte():child.EndByte()])
+		case "string_lit":
+			parts = append(parts, strings.Trim(source[child.StartByte():child.EndByte()], `"`))
+		case "block_start":
+			return parts
+		}
+	}
+	return parts
+}
+
+// extractHCLBlockType types a block by its keyword: "resource", "variable",
+// "module" and so on.
+func extractHCLBlockType(node *sitter.Node, source string) string {
+	if parts := hclBlockParts(node, source); len(parts) > 0 {
+		return parts[0]
+	}
+	return ""
+}
+
+// extractHCLNodeName joins a block's type and labels with dots, the way
+// Terraform addresses it: "resource.aws_instance.web", "variable.region".
+func extractHCLNodeName(node *sitter.Node, source string) string {
+	return strings.Join(hclBlockParts(node, source), ".")
+}
@@ -0,0 +1,88 @@
+package chunker
+
+import (
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+)
+
+// Elements are kept whole; an oversized element (<html>, <body>, a giant
+// <table>) descends into its child elements.
+var htmlSpec = astSpec{
+	targets: map[string]bool{
+		"element":        true,
+		"script_element": true,
+		"style_element":  true,
+	},
+	typeName: extractHTMLNodeType,
+	nodeName: extractHTMLNodeName,
+	descend:  true,
+}
+
+func (c *Chunker) chunkHTML(tree *sitter.Tree) ([]Chunk, error) {
+	return c.chunkAST(tree, htmlSpec)
+}
+
+func extractHTMLNodeType(nodeType string) string {
+	switch nodeType {
+	case "element":
+		return "element"
+	case "script_element":
+		return "script"
+	case "style_element":
+		return "style"
+	default:
+		return "code"
+	}
+}
+
+// extractHTMLNodeName names an element CSS-style from its tag, id and
+// classes, e.g. `div#main.container.wide`.
+func extractHTMLNodeName(node *sitter.Node, source string) string {
+	var tag *sitter.Node
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		child := node.NamedChild(i)
+		if child.Type() == "start_tag" || child.Type() == "self_closing_tag" {
+			tag = child
+			break
+		}
+	}
+	if tag == nil {
+		return ""
+	}
+
+	var name, id, classes string
+	for i := 0; i < int(tag.NamedChildCount()); i++ {
+		child := tag.NamedChild(i)
+		switch child.Type() {
+		case "tag_name":
+			name = child.Content([]byte(source))
+		case "attribute":
+			attrName, attrValue := htmlAttribute(child, source)
+			switch attrName {
+			case "id":
+				id = "#" + attrValue
+			case "class":
+				for _, class := range strings.Fields(attrValue) {
+					classes += "." + class
+				}
+			}
+		}
+	}
+	return name + id + classes
+}
+
+// htmlAttribute returns an attribute's name and unquoted value.
+func htmlAttribute(attr *sitter.Node, source string) (string, string) {
+	var name, value string
+	for i := 0; i < int(attr.NamedChildCount()); i++ {
+		child := attr.NamedChild(i)
+		switch child.Type() {
+		case "attribute_name":
+			name = child.Content([]byte(source))
+		case "attribute_value", "quoted_attribute_value":
+			value = strings.Trim(child.Content([]byte(source)), `"'`)
+		}
+	}
+	return name, value
+}
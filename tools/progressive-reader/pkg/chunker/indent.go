@@ -0,0 +1,58 @@
+package chunker
+
+import "strings"
+
+// commonIndent returns the leading whitespace shared by every non-empty line
+// of content. It is kept shorter than any whitespace-only line, so removing
+// it never turns a non-empty line into an empty one and dedent stays
+// reversible.
+func commonIndent(content string) string {
+	indent, first := "", true
+	for _, line := range strings.Split(content, "\n") {
+		if line == "" {
+			continue
+		}
+		lead := line[:len(line)-len(strings.TrimLeft(line, " \t"))]
+		if lead == line {
+			lead = lead[:len(lead)-1]
+		}
+		if first {
+			indent, first = lead, false
+			continue
+		}
+		n := 0
+		for n < len(indent) && n < len(lead) && indent[n] == lead[n] {
+			n++
+		}
+		indent = indent[:n]
+		if indent == "" {
+			break
+		}
+	}
+	return indent
+}
+
+// dedent removes indent from the start of every non-empty line of content.
+func dedent(content, indent string) string {
+	lines := strings.Split(content, "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimPrefix(line, indent)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// Reindent returns the chunk's content with the indentation removed by
+// Options.Dedent restored and any Options.LineMarkers header removed, exactly
+// as it appears in the source file.
+func (ch Chunk) Reindent() string {
+	if ch.Indent == "" {
+		return ch.Unmarked()
+	}
+	lines := strings.Split(ch.Unmarked(), "\n")
+	for i, line := range lines {
+		if line != "" {
+			lines[i] = ch.Indent + line
+		}
+	}
+	return strings.Join(lines, "\n")
+}
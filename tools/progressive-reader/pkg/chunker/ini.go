@@ -0,0 +1,95 @@
+package chunker
+
+import (
+	"regexp"
+	"strings"
+)
+
+// iniSection matches an INI section header, capturing its name, e.g.
+// "database" in `[database]` or "remote \"origin\"" in git config.
+var iniSection = regexp.MustCompile(`^\s*\[\s*([^\]]+?)\s*\]\s*(?:[;#].*)?$`)
+
+// chunkINI splits an INI, .env or .properties file. A file with `[section]`
+// headers is chunked at them, each section named after its header and taking
+// the comments directly above it; keys before the first header form the
+// preamble. A file without headers, as .env and .properties files usually
+// are, is chunked at its blank-line-separated blocks of keys, each named
+// after its first key. A block holding only comments leads into the block
+// below it.
+func (c *Chunker) chunkINI() ([]Chunk, error) {
+	if sections := c.iniSections(); len(sections) > 0 {
+		return c.chunkSections(sections), nil
+	}
+
+	var blocks []lineSection
+	lead := -1 // first line of the block being read
+	named := false
+	for i, line := range c.sourceLines {
+		trimmed := strings.TrimSpace(line)
+		switch {
+		case trimmed == "":
+			if named {
+				lead, named = -1, false
+			}
+		case iniComment(trimmed):
+			if lead < 0 {
+				lead = i
+			}
+		default:
+			if lead < 0 {
+				lead = i
+			}
+			if !named {
+				blocks = append(blocks, lineSection{start: lead, kind: "block", name: iniKey(trimmed)})
+				named = true
+			}
+		}
+	}
+	if len(blocks) == 0 {
+		return c.chunkFallback()
+	}
+	return c.chunkSections(blocks), nil
+}
+
+// iniSections returns the sections of a file with `[section]` headers, or
+// nil if it has none.
+func (c *Chunker) iniSections() []lineSection {
+	var sections []lineSection
+	lead := -1 // first line of the comments above the next header
+	for i, line := range c.sourceLines {
+		trimmed := strings.TrimSpace(line)
+		switch {
+		case iniComment(trimmed):
+			if lead < 0 {
+				lead = i
+			}
+		case iniSection.MatchString(trimmed):
+			start := i
+			if lead >= 0 {
+				start = lead
+			}
+			sections = append(sections, lineSection{start: start, kind: "section", name: iniSection.FindStringSubmatch(trimmed)[1]})
+			lead = -1
+		default:
+			lead = -1
+		}
+	}
+	return sections
+}
+
+// iniComment reports whether the trimmed line is a comment: `#` and `;` in
+// INI and .env files, `#` and `!` in .properties files.
+func iniComment(trimmed string) bool {
+	return trimmed != "" && strings.ContainsRune("#;!", rune(trimmed[0]))
+}
+
+// iniKey returns the key set on the trimmed line: the text before its `=`,
+// `:` or whitespace separator, without the `export` a .env file may put in
+// front.
+func iniKey(trimmed string) string {
+	trimmed = strings.TrimSpace(strings.TrimPrefix(trimmed, "export "))
+	if i := strings.IndexAny(trimmed, "=: \t"); i >= 0 {
+		trimmed = trimmed[:i]
+	}
+	return trimmed
+}
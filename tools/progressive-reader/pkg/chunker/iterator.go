@@ -0,0 +1,65 @@
+package chunker
+
+// ChunkIterator hands out a file's chunks one at a time. Chunking runs on the
+// first call to Next, and the iterator drops its reference to each chunk once
+// it has been yielded, so a consumer forwarding chunks elsewhere never keeps
+// the whole set alive. Stopping early only requires calling Close; there is
+// no goroutine to leak.
+//
+// A ChunkIterator is not safe for concurrent use.
+type ChunkIterator struct {
+	chunker *Chunker
+	chunks  []Chunk
+	next    int
+	started bool
+	err     error
+	// stream, if set, produces the chunks one at a time; see ChunkReader
+	stream *textStream
+}
+
+// Iterator returns an iterator over the file's chunks.
+func (c *Chunker) Iterator() *ChunkIterator {
+	return &ChunkIterator{chunker: c}
+}
+
+// Next returns the next chunk, or false when the chunks are exhausted or
+// chunking failed. Check Err after Next returns false.
+func (it *ChunkIterator) Next() (Chunk, bool) {
+	if it.stream != nil {
+		chunk, ok, err := it.stream.next()
+		if err != nil {
+			it.err = err
+		}
+		if !ok || err != nil {
+			it.Close()
+			return Chunk{}, false
+		}
+		return chunk, true
+	}
+	if !it.started {
+		it.started = true
+		it.chunks, it.err = it.chunker.ChunkFile()
+	}
+	if it.err != nil || it.next >= len(it.chunks) {
+		it.Close()
+		return Chunk{}, false
+	}
+
+	chunk := it.chunks[it.next]
+	it.chunks[it.next] = Chunk{}
+	it.next++
+	return chunk, true
+}
+
+// Err returns the error, if any, that stopped the iteration.
+func (it *ChunkIterator) Err() error {
+	return it.err
+}
+
+// Close releases any chunks that have not been yielded yet.
+func (it *ChunkIterator) Close() {
+	it.started = true
+	it.stream = nil
+	it.chunks = nil
+	it.next = 0
+}
@@ -0,0 +1,13 @@
+package chunker
+
+import (
+	sitter "github.com/smacker/go-tree-sitter"
+)
+
+// JSON is parsed with the YAML grammar (JSON is a subset of YAML), so a
+// document's top-level pairs arrive as flow_pair nodes.
+var jsonSpec = yamlSpec
+
+func (c *Chunker) chunkJSON(tree *sitter.Tree) ([]Chunk, error) {
+	return c.chunkAST(tree, jsonSpec)
+}
@@ -0,0 +1,75 @@
+package chunker
+
+import (
+	sitter "github.com/smacker/go-tree-sitter"
+)
+
+// jsxElements are the node types of JSX elements, which Options.SplitJSX
+// makes targets.
+var jsxElements = map[string]bool{
+	"jsx_element":              true,
+	"jsx_self_closing_element": true,
+}
+
+// withJSX returns spec extended for Options.SplitJSX: oversized declarations
+// descend to the targets they contain, and the JSX elements a component
+// returns are targets typed "jsx" and named after their tag. Elements are
+// only reached through an oversized ancestor, so a component is split at
+// the top-level elements of its return first and at deeper ones only when
+// those are oversized too.
+func withJSX(spec astSpec) astSpec {
+	targets := make(map[string]bool, len(spec.targets)+len(jsxElements))
+	for t := range spec.targets {
+		targets[t] = true
+	}
+	for t := range jsxElements {
+		targets[t] = true
+	}
+	spec.targets = targets
+	spec.descend = true
+	spec.match = func(node *sitter.Node, source string) bool {
+		return !jsxElements[node.Type()] || jsxReturned(node)
+	}
+	spec.kindName = func(node *sitter.Node, source string) string {
+		if jsxElements[node.Type()] {
+			return "jsx"
+		}
+		return ""
+	}
+	spec.nodeName = func(node *sitter.Node, source string) string {
+		if jsxElements[node.Type()] {
+			return jsxTagName(node, source)
+		}
+		return extractNodeName(node, source)
+	}
+	return spec
+}
+
+// jsxReturned reports whether the JSX element n is, or is nested in, the
+// value a function returns, as opposed to JSX passed as an argument or
+// assigned to a variable.
+func jsxReturned(n *sitter.Node) bool {
+	for p := n.Parent(); p != nil; p = p.Parent() {
+		switch p.Type() {
+		case "return_statement", "arrow_function":
+			return true
+		case "jsx_element", "parenthesized_expression":
+			continue
+		default:
+			return false
+		}
+	}
+	return false
+}
+
+// jsxTagName returns the tag of a JSX element, e.g. "div" or "Chart", or ""
+// for a fragment.
+func jsxTagName(n *sitter.Node, source string) string {
+	if open := n.ChildByFieldName("open_tag"); open != nil {
+		n = open
+	}
+	if name := n.ChildByFieldName("name"); name != nil {
+		return source[name.StartByte():name.EndByte()]
+	}
+	return ""
+}
@@ -0,0 +1,82 @@
+package chunker
+
+import (
+	"regexp"
+	"strings"
+)
+
+// juliaDeclaration matches the first line of a block declaration, capturing
+// its keyword and name. Macro calls such as @inline before it are skipped.
+var juliaDeclaration = regexp.MustCompile(`^(?:@\w+\s+)*(function|macro|module|baremodule|(?:mutable\s+)?struct|abstract\s+type|primitive\s+type|const)\s+([^\s({<=]+)`)
+
+// juliaShortFunction matches a short-form function definition such as
+// `f(x) = x^2` or `Base.show(io::IO, p::Point) = ...`, capturing its name.
+var juliaShortFunction = regexp.MustCompile(`^(?:@\w+\s+)*((?:[\w.]+\.)?(?:[\w!]+|[^\w\s(){}=]+))(?:\{[^}]*\})?\(.*\)\s*(?:::\s*[^=]+?)?\s*(?:where\s+[^=]+?)?\s*=(?:[^=>]|$)`)
+
+// juliaKinds maps declaration keywords to chunk types.
+var juliaKinds = map[string]string{
+	"function":   "function",
+	"macro":      "macro",
+	"module":     "module",
+	"baremodule": "module",
+	"struct":     "struct",
+	"type":       "type",
+	"const":      "variable",
+}
+
+// chunkJulia splits a Julia file at its top-level declarations: modules,
+// functions in block or short form (`f(x) = x^2`), macros, structs, abstract
+// and primitive types, and runs of consts, which share a chunk. There is no
+// tree-sitter grammar for Julia, so declarations are found by line: they
+// start unindented, as do the declarations inside a module by convention,
+// and the docstring or `#` comments directly above one belong to it. A
+// module chunk holds its using, import and export lines.
+func (c *Chunker) chunkJulia() ([]Chunk, error) {
+	var decls []lineSection
+	lead := -1 // first line of the docstring or comments above the next declaration
+	inDoc := false
+	for i, line := range c.sourceLines {
+		trimmed := strings.TrimSpace(line)
+		switch {
+		case inDoc:
+			inDoc = !strings.HasSuffix(trimmed, `"""`)
+		case strings.HasPrefix(trimmed, `"""`) && line[0] == '"':
+			if lead < 0 {
+				lead = i
+			}
+			inDoc = trimmed == `"""` || !strings.HasSuffix(trimmed[3:], `"""`)
+		case trimmed == "" || line[0] == ' ' || line[0] == '\t':
+			lead = -1
+		case strings.HasPrefix(trimmed, "#"):
+			if lead < 0 {
+				lead = i
+			}
+		default:
+			kind, name := "", ""
+			if m := juliaDeclaration.FindStringSubmatch(line); m != nil {
+				fields := strings.Fields(m[1])
+				kind, name = juliaKinds[fields[len(fields)-1]], m[2]
+			} else if m := juliaShortFunction.FindStringSubmatch(line); m != nil {
+				kind, name = "function", m[1]
+			}
+			if kind == "" {
+				lead = -1
+				continue
+			}
+			start := i
+			if lead >= 0 {
+				start = lead
+			}
+			lead = -1
+			// Consecutive consts stay together
+			if prev := len(decls) - 1; kind == "variable" && prev >= 0 && decls[prev].kind == "variable" && start == i {
+				continue
+			}
+			decls = append(decls, lineSection{start: start, kind: kind, name: name})
+		}
+	}
+	if len(decls) == 0 {
+		return c.chunkFallback()
+	}
+	return c.chunkSections(decls), nil
+}
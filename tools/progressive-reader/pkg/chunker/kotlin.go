@@ -0,0 +1,53 @@
+package chunker
+
+import (
+	sitter "github.com/smacker/go-tree-sitter"
+)
+
+var kotlinSpec = astSpec{
+	targets: map[string]bool{
+		"class_declaration":    true,
+		"object_declaration":   true,
+		"function_declaration": true,
+		"property_declaration": true,
+	},
+	typeName: extractKotlinNodeType,
+	nodeName: extractKotlinNodeName,
+	descend:  true,
+}
+
+func (c *Chunker) chunkKotlin(tree *sitter.Tree) ([]Chunk, error) {
+	return c.chunkAST(tree, kotlinSpec)
+}
+
+func extractKotlinNodeType(nodeType string) string {
+	switch nodeType {
+	case "class_declaration":
+		return "class"
+	case "object_declaration":
+		return "object"
+	case "function_declaration":
+		return "function"
+	case "property_declaration":
+		return "property"
+	default:
+		return "code"
+	}
+}
+
+// extractKotlinNodeName handles the Kotlin grammar's lack of name fields:
+// functions are named by a simple_identifier child, classes and objects by a
+// type_identifier, and properties by the identifier inside their
+// variable_declaration.
+func extractKotlinNodeName(node *sitter.Node, source string) string {
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		child := node.NamedChild(i)
+		switch child.Type() {
+		case "simple_identifier", "type_identifier":
+			return source[child.StartByte():child.EndByte()]
+		case "variable_declaration":
+			return extractKotlinNodeName(child, source)
+		}
+	}
+	return ""
+}
@@ -0,0 +1,107 @@
+package chunker
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// splitLongLines breaks up chunks holding a line that alone exceeds the token
+// budget, as minified JS and CSS do. The lines around it keep whole-line
+// chunks; the long line is cut into pieces that share its line number and are
+// told apart by StartByte/EndByte. Only the first piece starts at the line's
+// start, so the others drop the Indent Reindent would restore.
+func (c *Chunker) splitLongLines(chunks []Chunk) []Chunk {
+	maxChars := c.maxChars()
+	var split []Chunk
+	for _, chunk := range chunks {
+		if len(chunk.Content) <= maxChars {
+			split = append(split, chunk)
+			continue
+		}
+
+		lines := strings.Split(chunk.Content, "\n")
+		first := len(split)
+		runStart := 0
+		emitRun := func(end int) {
+			if end <= runStart {
+				return
+			}
+			piece := chunk
+			piece.Content = strings.Join(lines[runStart:end], "\n")
+			piece.StartLine = chunk.StartLine + runStart
+			piece.EndLine = chunk.StartLine + end - 1
+			split = append(split, piece)
+		}
+
+		for i, line := range lines {
+			if len(line) <= maxChars {
+				continue
+			}
+			emitRun(i)
+			runStart = i + 1
+
+			lineNum := chunk.StartLine + i
+			offset := c.contentLineOffset(chunk, lineNum)
+			for k, text := range splitLine(line, maxChars) {
+				piece := chunk
+				piece.Content = text
+				piece.StartLine = lineNum
+				piece.EndLine = lineNum
+				piece.StartByte = offset
+				piece.EndByte = offset + len(text)
+				if k > 0 {
+					piece.Indent = ""
+				}
+				offset += len(text)
+				split = append(split, piece)
+			}
+		}
+		emitRun(len(lines))
+
+		for i := first + 1; i < len(split); i++ {
+			if chunk.Name != "" {
+				split[i].Name = chunk.Name + " (cont.)"
+			}
+			split[i].Context = c.extractContext(split[i].Content)
+		}
+	}
+	return split
+}
+
+// splitLine cuts a line into pieces of at most maxChars bytes, backing off so
+// no UTF-8 sequence is split.
+func splitLine(line string, maxChars int) []string {
+	var pieces []string
+	for len(line) > maxChars {
+		cut := maxChars
+		for cut > 0 && !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+		if cut == 0 {
+			cut = maxChars
+		}
+		pieces = append(pieces, line[:cut])
+		line = line[cut:]
+	}
+	return append(pieces, line)
+}
+
+// contentLineOffset returns the byte offset in the source at which the
+// chunk's Content line on the 1-based line lineNum starts: past the indent
+// Options.Dedent removed from it, if any.
+func (c *Chunker) contentLineOffset(chunk Chunk, lineNum int) int {
+	offset := c.lineOffset(lineNum - 1)
+	if chunk.Indent != "" && lineNum-1 < len(c.sourceLines) && strings.HasPrefix(c.sourceLines[lineNum-1], chunk.Indent) {
+		offset += len(chunk.Indent)
+	}
+	return offset
+}
+
+// lineOffset returns the byte offset at which the 0-based line starts.
+func (c *Chunker) lineOffset(line int) int {
+	offset := 0
+	for i := 0; i < line && i < len(c.sourceLines); i++ {
+		offset += len(c.sourceLines[i]) + 1
+	}
+	return offset
+}
@@ -0,0 +1,52 @@
+package chunker
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLongLinePiecesMapToSource(t *testing.T) {
+	long := "    values = [" + strings.Repeat("1234567, ", 40) + "0]"
+	source := "def f():\n" + long + "\n    return values\n"
+	tests := []struct {
+		name string
+		opts Options
+	}{
+		{"token budget", Options{MaxTokens: 20}},
+		{"token budget dedented", Options{MaxTokens: 20, Dedent: true}},
+		{"MaxBytes dedented", Options{MaxTokens: 2000, MaxBytes: 100, Dedent: true}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := NewChunkerWithOptions("a.py", []byte(source), tt.opts)
+			if err != nil {
+				t.Fatal(err)
+			}
+			chunks, err := c.ChunkFile()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := ValidateChunks(chunks, c.LineCount()); err != nil {
+				t.Fatal(err)
+			}
+			var line strings.Builder
+			pieces := 0
+			for _, ch := range chunks {
+				if ch.EndByte == 0 {
+					continue
+				}
+				pieces++
+				if got := source[ch.StartByte:ch.EndByte]; got != ch.Content {
+					t.Errorf("bytes %d-%d hold %q, but Content is %q", ch.StartByte, ch.EndByte, got, ch.Content)
+				}
+				line.WriteString(ch.Reindent())
+			}
+			if pieces < 2 {
+				t.Fatalf("long line split into %d pieces, want several", pieces)
+			}
+			if line.String() != long {
+				t.Errorf("reindented pieces join to %q, want %q", line.String(), long)
+			}
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package chunker
+
+import (
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+)
+
+var luaSpec = astSpec{
+	targets: map[string]bool{
+		"function_statement":   true,
+		"variable_declaration": true,
+	},
+	typeName: extractLuaNodeType,
+	nodeName: extractLuaNodeName,
+	kindName: extractLuaFunctionKind,
+	match:    isLuaFunction,
+	descend:  true,
+}
+
+func (c *Chunker) chunkLua(tree *sitter.Tree) ([]Chunk, error) {
+	return c.chunkAST(tree, luaSpec)
+}
+
+func extractLuaNodeType(nodeType string) string {
+	switch nodeType {
+	case "function_statement", "variable_declaration":
+		return "function"
+	default:
+		return "code"
+	}
+}
+
+// isLuaFunction keeps function statements and assignments of a function
+// expression (`M.handler = function(...) ... end`); other assignments aren't
+// chunk boundaries.
+func isLuaFunction(node *sitter.Node, source string) bool {
+	if node.Type() != "variable_declaration" {
+		return true
+	}
+	value := node.ChildByFieldName("value")
+	return value != nil && value.Type() == "function"
+}
+
+// extractLuaFunctionKind tells `local function` and `function M:method`
+// apart from plain functions.
+func extractLuaFunctionKind(node *sitter.Node, source string) string {
+	if node.Type() != "function_statement" {
+		return ""
+	}
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		if node.NamedChild(i).Type() == "local" {
+			return "local_function"
+		}
+	}
+	if name := node.ChildByFieldName("name"); name != nil {
+		for i := 0; i < int(name.NamedChildCount()); i++ {
+			if name.NamedChild(i).Type() == "table_colon" {
+				return "method"
+			}
+		}
+	}
+	return ""
+}
+
+// extractLuaNodeName returns the full function name, keeping table paths
+// such as "M.new" and "M:add". The grammar counts the whitespace before a
+// node as part of it, so the name is trimmed.
+func extractLuaNodeName(node *sitter.Node, source string) string {
+	name := node.ChildByFieldName("name")
+	for i := 0; i < int(node.NamedChildCount()) && name == nil; i++ {
+		// The grammar doesn't attach the field to an assignment's target
+		if child := node.NamedChild(i); child.Type() == "variable_declarator" {
+			name = child
+		}
+	}
+	if name == nil {
+		return ""
+	}
+	return strings.TrimSpace(source[name.StartByte():name.EndByte()])
+}
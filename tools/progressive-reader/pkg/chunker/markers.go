@@ -0,0 +1,80 @@
+package chunker
+
+import (
+	"fmt"
+	"strings"
+)
+
+// lineComments gives the comment delimiters used for Options.LineMarkers in
+// languages that don't use `//`. JSON and plain text have no comment syntax,
+// so their chunks get no marker.
+var lineComments = map[string][2]string{
+	"python":     {"#", ""},
+	"ruby":       {"#", ""},
+	"bash":       {"#", ""},
+	"yaml":       {"#", ""},
+	"dockerfile": {"#", ""},
+	"graphql":    {"#", ""},
+	"elixir":     {"#", ""},
+	"hcl":        {"#", ""},
+	"lua":        {"--", ""},
+	"haskell":    {"--", ""},
+	"perl":       {"#", ""},
+	"r":          {"#", ""},
+	"julia":      {"#", ""},
+	"wat":        {";;", ""},
+	"ini":        {"#", ""},
+	"env":        {"#", ""},
+	"properties": {"#", ""},
+	"sql":        {"--", ""},
+	"css":        {"/*", "*/"},
+	"scss":       {"/*", "*/"},
+	"less":       {"/*", "*/"},
+	"html":       {"<!--", "-->"},
+	"markdown":   {"<!--", "-->"},
+	"vue":        {"<!--", "-->"},
+	"svelte":     {"<!--", "-->"},
+	"json":       {"", ""},
+	"text":       {"", ""},
+}
+
+// addLineMarkers prefixes each chunk's content with a comment line giving its
+// position, e.g. "// chunk 3/12 lines 120-160", and records that line in
+// Marker so Unmarked can strip it again. Chunks are marked after they are
+// finalized, so Hash and Tokens cover the unmarked content.
+func (c *Chunker) addLineMarkers(chunks []Chunk) {
+	delims, ok := lineComments[c.parser.GetLanguage()]
+	if !ok {
+		delims = [2]string{"//", ""}
+	}
+	if delims[0] == "" {
+		return
+	}
+
+	for i := range chunks {
+		marker := fmt.Sprintf("%s chunk %d/%d lines %d-%d", delims[0],
+			chunks[i].CurrentChunk+1, chunks[i].TotalChunks, chunks[i].StartLine, chunks[i].EndLine)
+		// Methods folded in by GroupMethods follow the chunk's own lines
+		for _, r := range chunks[i].Grouped {
+			marker += fmt.Sprintf(", %d-%d", r.Start, r.End)
+		}
+		if delims[1] != "" {
+			marker += " " + delims[1]
+		}
+		chunks[i].Content = marker + "\n" + chunks[i].Content
+		// Directives prepended by PrependStyleImports come after the marker
+		if chunks[i].Marker != "" {
+			marker += "\n" + chunks[i].Marker
+		}
+		chunks[i].Marker = marker
+	}
+}
+
+// Unmarked returns the chunk's content without the header lines added by
+// Options.LineMarkers and Options.PrependStyleImports.
+func (ch Chunk) Unmarked() string {
+	if ch.Marker == "" {
+		return ch.Content
+	}
+	return strings.TrimPrefix(ch.Content, ch.Marker+"\n")
+}
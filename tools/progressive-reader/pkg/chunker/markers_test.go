@@ -0,0 +1,41 @@
+package chunker
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestMarkersLeaveHashUnmarked(t *testing.T) {
+	const scss = "@use \"a\";\n.a { color: red; }\n.b { color: blue; }\n"
+	tests := []struct {
+		name   string
+		path   string
+		source string
+		opts   Options
+		header string // the header lines of the last chunk's Content
+	}{
+		{"line markers", "shapes.go", shapesSource, Options{MaxTokens: 20, LineMarkers: true}, "// chunk 3/3 lines 10-13"},
+		{"style imports", "app.scss", scss, Options{MaxTokens: 5, PrependStyleImports: true}, "@use \"a\";"},
+		{"both", "app.scss", scss, Options{MaxTokens: 5, LineMarkers: true, PrependStyleImports: true}, "/* chunk 3/3 lines 3-3 */\n@use \"a\";"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			marked := chunkSource(t, tt.path, tt.source, tt.opts)
+			plain := chunkSource(t, tt.path, tt.source, Options{MaxTokens: tt.opts.MaxTokens})
+			if len(marked) != len(plain) {
+				t.Fatalf("%d chunks with headers, %d without", len(marked), len(plain))
+			}
+			if last := marked[len(marked)-1]; !strings.HasPrefix(last.Content, tt.header+"\n") {
+				t.Fatalf("last chunk's Content is %q, want it to start with %q", last.Content, tt.header)
+			}
+			for i, ch := range marked {
+				if ch.Hash != hashContent(ch.Unmarked()) || ch.Hash != plain[i].Hash {
+					t.Errorf("chunk %d Hash isn't that of its unmarked content", i)
+				}
+				if ch.Tokens != plain[i].Tokens {
+					t.Errorf("chunk %d Tokens = %d, want %d as without headers", i, ch.Tokens, plain[i].Tokens)
+				}
+			}
+		})
+	}
+}
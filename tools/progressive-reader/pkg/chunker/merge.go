@@ -0,0 +1,22 @@
+package chunker
+
+// MergeChunks flattens the chunks of several files into one corpus numbered
+// as a whole, recording each chunk's file in FileIndex. PrevName, and
+// NextName if the files were chunked with PeekNext, link across files.
+func MergeChunks(files []FileChunks) []Chunk {
+	var merged []Chunk
+	peekNext := false
+	for i, f := range files {
+		for _, ch := range f.Chunks {
+			ch.FileIndex = i
+			if ch.FilePath == "" {
+				ch.FilePath = f.Path
+			}
+			peekNext = peekNext || ch.NextName != ""
+			merged = append(merged, ch)
+		}
+	}
+	numberChunks(merged)
+	linkNames(merged, peekNext)
+	return merged
+}
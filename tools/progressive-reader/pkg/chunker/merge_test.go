@@ -0,0 +1,53 @@
+package chunker
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMergeChunks(t *testing.T) {
+	a := FileChunks{Path: "a.go", Chunks: []Chunk{{Name: "A1", FilePath: "a.go"}, {Name: "A2", FilePath: "a.go"}}}
+	b := FileChunks{Path: "b.go", Chunks: []Chunk{{Name: "B1"}}}
+	empty := FileChunks{Path: "empty.go"}
+	tests := []struct {
+		name      string
+		files     []FileChunks
+		names     []string
+		fileIndex []int
+		paths     []string
+		prevNames []string
+	}{
+		{"none", nil, nil, nil, nil, nil},
+		{"one file", []FileChunks{a}, []string{"A1", "A2"}, []int{0, 0}, []string{"a.go", "a.go"}, []string{"", "A1"}},
+		{"two files", []FileChunks{a, b}, []string{"A1", "A2", "B1"}, []int{0, 0, 1}, []string{"a.go", "a.go", "b.go"}, []string{"", "A1", "A2"}},
+		{"empty file between", []FileChunks{b, empty, a}, []string{"B1", "A1", "A2"}, []int{0, 2, 2}, []string{"b.go", "a.go", "a.go"}, []string{"", "B1", "A1"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			merged := MergeChunks(tt.files)
+			var names, paths, prevNames []string
+			var fileIndex []int
+			for i, ch := range merged {
+				names = append(names, ch.Name)
+				paths = append(paths, ch.FilePath)
+				prevNames = append(prevNames, ch.PrevName)
+				fileIndex = append(fileIndex, ch.FileIndex)
+				if ch.CurrentChunk != i || ch.TotalChunks != len(merged) || ch.HasMore != (i < len(merged)-1) {
+					t.Errorf("chunk %q numbered %d/%d, HasMore %v; want %d/%d", ch.Name, ch.CurrentChunk, ch.TotalChunks, ch.HasMore, i, len(merged))
+				}
+			}
+			if !reflect.DeepEqual(names, tt.names) {
+				t.Errorf("names = %q, want %q", names, tt.names)
+			}
+			if !reflect.DeepEqual(fileIndex, tt.fileIndex) {
+				t.Errorf("FileIndex = %v, want %v", fileIndex, tt.fileIndex)
+			}
+			if !reflect.DeepEqual(paths, tt.paths) {
+				t.Errorf("FilePath = %q, want %q", paths, tt.paths)
+			}
+			if !reflect.DeepEqual(prevNames, tt.prevNames) {
+				t.Errorf("PrevName = %q, want %q", prevNames, tt.prevNames)
+			}
+		})
+	}
+}
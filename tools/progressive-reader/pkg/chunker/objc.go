@@ -0,0 +1,298 @@
+package chunker
+
+import (
+	"regexp"
+	"strings"
+)
+
+// objcContainer matches the first line of an @interface, @implementation or
+// @protocol block, capturing its keyword, class name and, for a category or
+// class extension, the parenthesized category name.
+var objcContainer = regexp.MustCompile(`^@(interface|implementation|protocol)\s+(\w+)(?:\s*\(\s*(\w*)\s*\))?`)
+
+// objcEnum matches an NS_ENUM or NS_OPTIONS typedef, capturing its name.
+var objcEnum = regexp.MustCompile(`^typedef\s+NS_(?:CLOSED_)?(?:ENUM|OPTIONS)\s*\(\s*\w+\s*,\s*(\w+)\s*\)`)
+
+// objcStruct matches a C struct, union or enum declaration or typedef,
+// capturing its keyword and tag if it has one.
+var objcStruct = regexp.MustCompile(`^(?:typedef\s+)?(struct|union|enum)\b\s*(\w*)`)
+
+// objcFunction matches a C function definition or prototype, capturing its
+// name. Storage and inline specifiers and the return type are skipped.
+var objcFunction = regexp.MustCompile(`^(?:(?:static|inline|extern|FOUNDATION_EXPORT|NS_INLINE)\s+)*(?:const\s+)?\w+(?:\s*\*+\s*|\s+)(?:const\s+)?(\w+)\s*\(`)
+
+// objcVariable matches a global variable or constant, capturing its name.
+var objcVariable = regexp.MustCompile(`^(?:(?:static|extern|const|FOUNDATION_EXPORT)\s+)*[\w<>]+[\s*]+(?:const\s+)?(\w+)\s*(?:=|;|\[)`)
+
+// objcSelectorPart matches one labelled part of a method selector, such as
+// "password:" in "signInWithEmail:password:".
+var objcSelectorPart = regexp.MustCompile(`(\w+)\s*:`)
+
+// objcKinds maps container keywords to chunk types; categories and class
+// extensions of an @interface are typed apart from the class itself.
+var objcKinds = map[string]string{
+	"interface":      "interface",
+	"implementation": "implementation",
+	"protocol":       "protocol",
+}
+
+// chunkObjC splits an Objective-C file at its top-level declarations:
+// @interface, @implementation and @protocol blocks, categories and class
+// extensions, C functions, enums and structs, with runs of global variables,
+// prototypes or macros sharing a chunk. There is no tree-sitter grammar for
+// Objective-C, so declarations are found by line: they start unindented, a
+// block runs to its @end, and the comments directly above one and the
+// `#pragma mark` heading its section belong to it. A block too large for maxTokens is split at its
+// methods and properties. Imports and forward declarations form the
+// preamble.
+func (c *Chunker) chunkObjC() ([]Chunk, error) {
+	var decls []lineSection
+	lead := -1 // first line of the comments above the next declaration
+	inComment, inBlock := false, false
+	for i, line := range c.sourceLines {
+		trimmed := strings.TrimSpace(line)
+		switch {
+		case inComment:
+			inComment = !strings.Contains(trimmed, "*/")
+		case inBlock:
+			inBlock = !strings.HasPrefix(trimmed, "@end")
+		case trimmed == "" && c.objcMarked(lead):
+		case trimmed == "" || line[0] == ' ' || line[0] == '\t' || line[0] == '}':
+			lead = -1
+		case strings.HasPrefix(trimmed, "/*"):
+			if lead < 0 {
+				lead = i
+			}
+			inComment = !strings.Contains(trimmed, "*/")
+		case strings.HasPrefix(trimmed, "//") || strings.HasPrefix(trimmed, "#pragma mark"):
+			if lead < 0 {
+				lead = i
+			}
+		case isBoilerplate("objc", trimmed) || trimmed[0] == '#' && !strings.HasPrefix(trimmed, "#define"):
+			lead = -1
+		default:
+			kind, name := objcDeclaration(trimmed)
+			if kind == "" {
+				lead = -1
+				continue
+			}
+			start := i
+			if lead >= 0 {
+				start = lead
+			}
+			lead = -1
+			if m := objcContainer.FindStringSubmatch(trimmed); m != nil {
+				inBlock = !strings.Contains(trimmed, "@end")
+			}
+			// Runs of globals, prototypes and macros stay together
+			if prev := len(decls) - 1; (kind == "variable" || kind == "declaration" || kind == "macro") &&
+				prev >= 0 && decls[prev].kind == kind && start == i {
+				continue
+			}
+			decls = append(decls, lineSection{start: start, kind: kind, name: name})
+		}
+	}
+	if len(decls) == 0 {
+		return c.chunkFallback()
+	}
+
+	var chunks []Chunk
+	if preamble := c.linesToString(0, decls[0].start-1); strings.TrimSpace(preamble) != "" {
+		chunks = append(chunks, c.splitLineRange(0, decls[0].start-1, "preamble", "")...)
+	}
+	for i, d := range decls {
+		end := c.LineCount() - 1
+		if i+1 < len(decls) {
+			end = decls[i+1].start - 1
+		}
+		if objcBlock(d.kind) && c.linesTokens(d.start, end) > c.maxTokens {
+			chunks = append(chunks, c.chunkObjCMembers(d, end)...)
+			continue
+		}
+		chunks = append(chunks, c.splitLineRange(d.start, end, d.kind, d.name)...)
+	}
+	return chunks, nil
+}
+
+// objcDeclaration returns the chunk type and name of the top-level
+// declaration starting on the trimmed line, or "" if it does not start one.
+// A category is named like "AuthManager (Testing)".
+func objcDeclaration(trimmed string) (kind, name string) {
+	if m := objcContainer.FindStringSubmatch(trimmed); m != nil {
+		// `@protocol Foo;` only declares the name
+		if strings.HasSuffix(trimmed, ";") {
+			return "", ""
+		}
+		kind, name = objcKinds[m[1]], m[2]
+		if strings.Contains(m[0], "(") {
+			if m[3] == "" {
+				if kind == "interface" {
+					kind = "extension"
+				}
+			} else {
+				name += " (" + m[3] + ")"
+				if kind == "interface" {
+					kind = "category"
+				}
+			}
+		}
+		return kind, name
+	}
+	if m := objcEnum.FindStringSubmatch(trimmed); m != nil {
+		return "enum", m[1]
+	}
+	if m := objcStruct.FindStringSubmatch(trimmed); m != nil {
+		return m[1], m[2]
+	}
+	if strings.HasPrefix(trimmed, "typedef") {
+		return "typedef", ""
+	}
+	if fields := strings.Fields(trimmed); fields[0] == "#define" {
+		// A bare `#define GUARD_H` is part of an include guard
+		if len(fields) < 3 {
+			return "", ""
+		}
+		return "macro", strings.SplitN(fields[1], "(", 2)[0]
+	}
+	if m := objcFunction.FindStringSubmatch(trimmed); m != nil {
+		if strings.HasSuffix(trimmed, ";") {
+			return "declaration", m[1]
+		}
+		return "function", m[1]
+	}
+	if m := objcVariable.FindStringSubmatch(trimmed); m != nil {
+		return "variable", m[1]
+	}
+	return "", ""
+}
+
+// objcBlock reports whether kind is an @interface, @implementation or
+// @protocol block, which chunkObjCMembers can split.
+func objcBlock(kind string) bool {
+	switch kind {
+	case "interface", "category", "extension", "implementation", "protocol":
+		return true
+	}
+	return false
+}
+
+// chunkObjCMembers splits the block d, which ends on line end, at its
+// methods and properties. The lines up to the first member form a chunk of
+// d's type; each method is chunked on its own and runs of properties
+// together, with d as their parent. Members start unindented with `-`, `+`
+// or `@property`; the comments and `#pragma mark`s above one lead in.
+func (c *Chunker) chunkObjCMembers(d lineSection, end int) []Chunk {
+	var members []lineSection
+	lead := -1
+	inComment := false
+	for i := d.start + 1; i <= end; i++ {
+		line := c.sourceLines[i]
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "@end") {
+			break
+		}
+		switch {
+		case inComment:
+			inComment = !strings.Contains(trimmed, "*/")
+		case trimmed == "" && c.objcMarked(lead):
+		case trimmed == "" || line[0] == ' ' || line[0] == '\t' || line[0] == '}' || line[0] == '{':
+			lead = -1
+		case strings.HasPrefix(trimmed, "/*"):
+			if lead < 0 {
+				lead = i
+			}
+			inComment = !strings.Contains(trimmed, "*/")
+		case strings.HasPrefix(trimmed, "//") || strings.HasPrefix(trimmed, "#pragma mark"):
+			if lead < 0 {
+				lead = i
+			}
+		case trimmed[0] == '-' || trimmed[0] == '+' || strings.HasPrefix(trimmed, "@property"):
+			kind, name := "method", objcSelector(trimmed)
+			if trimmed[0] == '@' {
+				kind, name = "property", objcProperty(trimmed)
+			}
+			start := i
+			if lead >= 0 {
+				start = lead
+			}
+			lead = -1
+			if prev := len(members) - 1; kind == "property" && prev >= 0 && members[prev].kind == "property" && start == i {
+				continue
+			}
+			members = append(members, lineSection{start: start, kind: kind, name: name})
+		default:
+			lead = -1
+		}
+	}
+	if len(members) == 0 {
+		return c.splitLineRange(d.start, end, d.kind, d.name)
+	}
+
+	chunks := c.splitLineRange(d.start, members[0].start-1, d.kind, d.name)
+	for i, m := range members {
+		last := end
+		if i+1 < len(members) {
+			last = members[i+1].start - 1
+		}
+		pieces := c.splitLineRange(m.start, last, m.kind, m.name)
+		for j := range pieces {
+			pieces[j].Parent = d.name
+		}
+		chunks = append(chunks, pieces...)
+	}
+	return chunks
+}
+
+// objcMarked reports whether lead, the first line of the comments above the
+// next declaration, is a `#pragma mark`, which names the section below it
+// and so leads in past blank lines.
+func (c *Chunker) objcMarked(lead int) bool {
+	return lead >= 0 && strings.HasPrefix(strings.TrimSpace(c.sourceLines[lead]), "#pragma mark")
+}
+
+// objcSelector returns the selector of the method declared on the trimmed
+// line, e.g. "signInWithEmail:password:" for
+// `- (void)signInWithEmail:(NSString *)email password:(NSString *)password`.
+func objcSelector(trimmed string) string {
+	s := strings.TrimSpace(trimmed[1:])
+	// Skip the return type, which may itself hold parentheses
+	if strings.HasPrefix(s, "(") {
+		depth := 0
+		for i, r := range s {
+			if r == '(' {
+				depth++
+			} else if r == ')' {
+				if depth--; depth == 0 {
+					s = s[i+1:]
+					break
+				}
+			}
+		}
+	}
+	if i := strings.IndexAny(s, "{;"); i >= 0 {
+		s = s[:i]
+	}
+	parts := objcSelectorPart.FindAllStringSubmatch(s, -1)
+	if len(parts) == 0 {
+		if fields := strings.Fields(s); len(fields) > 0 {
+			return fields[0]
+		}
+		return ""
+	}
+	var selector strings.Builder
+	for _, p := range parts {
+		selector.WriteString(p[1] + ":")
+	}
+	return selector.String()
+}
+
+// objcProperty returns the name of the property declared on the trimmed
+// line: the identifier before its closing semicolon.
+func objcProperty(trimmed string) string {
+	s := strings.TrimSuffix(strings.TrimSpace(strings.SplitN(trimmed, ";", 2)[0]), ";")
+	fields := strings.FieldsFunc(s, func(r rune) bool { return !isWordRune(r) && r != '_' })
+	if len(fields) == 0 {
+		return ""
+	}
+	return fields[len(fields)-1]
+}
@@ -0,0 +1,100 @@
+package chunker
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestChunkOutlineMatchesChunkFile(t *testing.T) {
+	paths, err := filepath.Glob("../../testdata/*/*")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, path := range paths {
+		source, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, opts := range []Options{
+			{MaxTokens: 50},
+			{MaxTokens: 500, Overview: true},
+			{MaxTokens: 50, MaxChunks: 3},
+			{MaxTokens: 50, OneChunkPerSymbol: true, MaxDepth: 1},
+		} {
+			c, err := NewChunkerWithOptions(path, source, opts)
+			if err != nil {
+				continue
+			}
+			chunks, err := c.ChunkFile()
+			if err != nil {
+				continue
+			}
+			want := make([]ChunkMeta, len(chunks))
+			for i := range chunks {
+				want[i] = chunks[i].Meta()
+			}
+			got, err := c.ChunkOutline()
+			if err != nil {
+				t.Fatalf("%s: %v", path, err)
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("%s %+v: ChunkOutline differs from ChunkFile's metadata\ngot  %+v\nwant %+v", path, opts, got, want)
+			}
+		}
+	}
+}
+
+func TestChunkOutlineSkipsContent(t *testing.T) {
+	source := benchmarkSource(5000)
+	allocs := func(outline bool) float64 {
+		return testing.AllocsPerRun(3, func() {
+			c, err := NewChunker("widgets.rb", source, 200)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if outline {
+				_, err = c.ChunkOutline()
+			} else {
+				_, err = c.ChunkFile()
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+	file, outline := allocs(false), allocs(true)
+	if outline >= file {
+		t.Errorf("ChunkOutline made %.0f allocations, ChunkFile %.0f; want fewer", outline, file)
+	}
+}
+
+func TestSummarizeMatchesChunkFile(t *testing.T) {
+	source := benchmarkSource(500)
+	c, err := NewChunker("widgets.rb", source, 200)
+	if err != nil {
+		t.Fatal(err)
+	}
+	chunks, err := c.ChunkFile()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := ChunkSummary{Language: "ruby", TotalChunks: len(chunks), TotalTokens: TotalTokens(chunks), Types: map[string]int{}}
+	for i := range chunks {
+		want.Types[chunks[i].Type]++
+	}
+
+	var got ChunkSummary
+	allocs := testing.AllocsPerRun(3, func() {
+		if got, err = c.Summarize(); err != nil {
+			t.Fatal(err)
+		}
+	})
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Summarize() = %+v, want %+v", got, want)
+	}
+	if file := testing.AllocsPerRun(3, func() { c.ChunkFile() }); allocs >= file {
+		t.Errorf("Summarize made %.0f allocations, ChunkFile %.0f; want fewer", allocs, file)
+	}
+}
@@ -0,0 +1,137 @@
+package chunker
+
+import (
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+)
+
+// extractParameters lists the parameters of a function or method node, one
+// entry per parameter: "name type" for Go, the name for Python, and the
+// declared text without its default value elsewhere (e.g. "name: type" for
+// TypeScript). It returns nil for nodes without a parameter list.
+func extractParameters(node, root *sitter.Node, source string) []string {
+	if node == nil || node == root {
+		return nil
+	}
+	decl := unwrapDeclaration(node)
+
+	var params []*sitter.Node
+	list := decl.ChildByFieldName("parameters")
+	for i := 0; i < int(decl.NamedChildCount()) && list == nil; i++ {
+		switch child := decl.NamedChild(i); child.Type() {
+		case "function_value_parameters":
+			// Kotlin
+			list = child
+		case "parameter_list":
+			// Lua
+			list = child
+		case "parameter":
+			// Swift, whose parameters aren't wrapped in a list
+			params = append(params, child)
+		}
+	}
+	if list != nil {
+		for i := 0; i < int(list.NamedChildCount()); i++ {
+			params = append(params, list.NamedChild(i))
+		}
+	}
+	if list == nil && len(params) == 0 {
+		return nil
+	}
+
+	python := list != nil && list.Type() == "parameters"
+	result := []string{}
+	for _, param := range params {
+		kind := param.Type()
+		if kind != "identifier" && !strings.Contains(kind, "parameter") && !strings.HasSuffix(kind, "pattern") {
+			// Comments, separators and Kotlin default values
+			continue
+		}
+		switch {
+		case kind == "parameter_declaration":
+			result = append(result, goParameters(param, source)...)
+		case python:
+			result = append(result, pythonParameterName(param, source))
+		default:
+			result = append(result, parameterText(param, source))
+		}
+	}
+	return result
+}
+
+// extractReturnType returns the declared result type of a function or method
+// node: Go's result, or the annotation after the parameter list in
+// TypeScript, Python, PHP and C#, without its leading ":" or "->".
+func extractReturnType(node, root *sitter.Node, source string) string {
+	if node == nil || node == root {
+		return ""
+	}
+	decl := unwrapDeclaration(node)
+	for _, field := range []string{"result", "return_type", "returns"} {
+		if typ := decl.ChildByFieldName(field); typ != nil {
+			text := collapseSpace(source[typ.StartByte():typ.EndByte()])
+			text = strings.TrimPrefix(strings.TrimPrefix(text, ":"), "->")
+			return strings.TrimSpace(text)
+		}
+	}
+	return ""
+}
+
+// goParameters expands a Go parameter declaration into one "name type" entry
+// per name, so `a, b int` gives "a int" and "b int".
+func goParameters(param *sitter.Node, source string) []string {
+	typ := param.ChildByFieldName("type")
+	if typ == nil {
+		return nil
+	}
+	typeText := collapseSpace(source[typ.StartByte():typ.EndByte()])
+
+	var params []string
+	for i := 0; i < int(param.ChildCount()); i++ {
+		if param.FieldNameForChild(i) == "name" {
+			name := param.Child(i)
+			params = append(params, source[name.StartByte():name.EndByte()]+" "+typeText)
+		}
+	}
+	if len(params) == 0 {
+		return []string{typeText}
+	}
+	return params
+}
+
+// pythonParameterName returns the name of a Python parameter, keeping the
+// * or ** of splat parameters.
+func pythonParameterName(param *sitter.Node, source string) string {
+	switch param.Type() {
+	case "typed_parameter":
+		if param.NamedChildCount() > 0 {
+			name := param.NamedChild(0)
+			return source[name.StartByte():name.EndByte()]
+		}
+	case "default_parameter", "typed_default_parameter":
+		return extractFieldName(param, source)
+	}
+	return source[param.StartByte():param.EndByte()]
+}
+
+// parameterText returns a parameter's source text up to its default value.
+func parameterText(param *sitter.Node, source string) string {
+	end := param.EndByte()
+	for _, field := range []string{"value", "default_value", "right"} {
+		if value := param.ChildByFieldName(field); value != nil && value.StartByte() < end {
+			end = value.StartByte()
+		}
+	}
+	for i := 0; i < int(param.ChildCount()); i++ {
+		if child := param.Child(i); child.Type() == "=" && child.StartByte() < end {
+			end = child.StartByte()
+		}
+	}
+	text := collapseSpace(source[param.StartByte():end])
+	return strings.TrimSpace(strings.TrimSuffix(text, "="))
+}
+
+func collapseSpace(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
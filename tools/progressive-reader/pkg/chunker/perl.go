@@ -0,0 +1,62 @@
+package chunker
+
+import (
+	"regexp"
+	"strings"
+)
+
+// perlDeclaration matches the first line of a package or named subroutine,
+// capturing its keyword and fully qualified name.
+var perlDeclaration = regexp.MustCompile(`^(package|sub)\s+([\w:]+)`)
+
+// chunkPerl splits a Perl file at its package statements and named
+// subroutines; a package chunk holds the imports and variables between its
+// statement and its first subroutine. There is no tree-sitter grammar for
+// Perl, so declarations are found by line: they start unindented, and the
+// `#` comments directly above one belong to it, as does a POD block above it
+// even when a blank line follows its `=cut`. Anything after __END__ or
+// __DATA__ stays with the last declaration.
+func (c *Chunker) chunkPerl() ([]Chunk, error) {
+	var decls []lineSection
+	lead := -1 // first line of the comments above the next declaration
+	inPod, podLead := false, false
+	for i, line := range c.sourceLines {
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "__END__" || trimmed == "__DATA__" {
+			break
+		}
+		switch {
+		case inPod:
+			inPod = !strings.HasPrefix(line, "=cut")
+		case strings.HasPrefix(line, "="):
+			if lead < 0 {
+				lead, podLead = i, true
+			}
+			inPod = !strings.HasPrefix(line, "=cut")
+		case trimmed == "" && podLead:
+		case trimmed == "" || line[0] == ' ' || line[0] == '\t' || strings.HasPrefix(trimmed, "#!"):
+			lead, podLead = -1, false
+		case strings.HasPrefix(trimmed, "#"):
+			if lead < 0 {
+				lead = i
+			}
+		default:
+			if m := perlDeclaration.FindStringSubmatch(line); m != nil {
+				kind := "package"
+				if m[1] == "sub" {
+					kind = "function"
+				}
+				start := i
+				if lead >= 0 {
+					start = lead
+				}
+				decls = append(decls, lineSection{start: start, kind: kind, name: m[2]})
+			}
+			lead, podLead = -1, false
+		}
+	}
+	if len(decls) == 0 {
+		return c.chunkFallback()
+	}
+	return c.chunkSections(decls), nil
+}
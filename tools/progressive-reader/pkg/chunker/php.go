@@ -0,0 +1,47 @@
+package chunker
+
+import (
+	sitter "github.com/smacker/go-tree-sitter"
+)
+
+// Inline HTML outside <?php ... ?> arrives as text nodes; they are isolated
+// so markup and PHP code never share a chunk.
+var phpSpec = astSpec{
+	targets: map[string]bool{
+		"function_definition":   true,
+		"method_declaration":    true,
+		"class_declaration":     true,
+		"interface_declaration": true,
+		"trait_declaration":     true,
+		"text":                  true,
+	},
+	isolate: map[string]bool{
+		"text": true,
+	},
+	typeName: extractPHPNodeType,
+	nodeName: extractFieldName,
+	descend:  true,
+}
+
+func (c *Chunker) chunkPHP(tree *sitter.Tree) ([]Chunk, error) {
+	return c.chunkAST(tree, phpSpec)
+}
+
+func extractPHPNodeType(nodeType string) string {
+	switch nodeType {
+	case "function_definition":
+		return "function"
+	case "method_declaration":
+		return "method"
+	case "class_declaration":
+		return "class"
+	case "interface_declaration":
+		return "interface"
+	case "trait_declaration":
+		return "trait"
+	case "text":
+		return "html"
+	default:
+		return "code"
+	}
+}
@@ -0,0 +1,41 @@
+package chunker
+
+import "testing"
+
+func TestOnProgressIsMonotonic(t *testing.T) {
+	source := benchmarkSource(2400)
+	tests := []struct {
+		name string
+		opts Options
+	}{
+		{"single pass", Options{MaxTokens: 200}},
+		{"MaxChunks retries", Options{MaxTokens: 200, MaxChunks: 3}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls [][2]int
+			tt.opts.OnProgress = func(lines, total int) {
+				calls = append(calls, [2]int{lines, total})
+			}
+			c, err := NewChunkerWithOptions("widgets.rb", source, tt.opts)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, err := c.ChunkFile(); err != nil {
+				t.Fatal(err)
+			}
+			if len(calls) < 2 {
+				t.Fatalf("OnProgress called %d times, want at least 2", len(calls))
+			}
+			total := c.LineCount()
+			if last := calls[len(calls)-1]; last != [2]int{total, total} {
+				t.Errorf("last OnProgress call reported %d of %d lines, want %d of %d", last[0], last[1], total, total)
+			}
+			for i := 1; i < len(calls); i++ {
+				if calls[i][0] < calls[i-1][0] {
+					t.Fatalf("progress went back from %d to %d: %v", calls[i-1][0], calls[i][0], calls)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,131 @@
+package chunker
+
+import (
+	"path/filepath"
+	"strings"
+)
+
+// isProse reports whether a text file holds prose, which is split at
+// paragraphs and sentences rather than by line count.
+func isProse(filePath string) bool {
+	return strings.EqualFold(filepath.Ext(filePath), ".txt")
+}
+
+// chunkProse packs paragraphs (runs of non-blank lines) into chunks of at
+// most maxTokens. A paragraph too large on its own is split between
+// sentences instead of mid-sentence. The blank lines after a paragraph go
+// with it, and any the file opens with go with the first.
+func (c *Chunker) chunkProse() ([]Chunk, error) {
+	var chunks []Chunk
+	currentStart, currentEnd := -1, -1
+	currentTokens := 0
+
+	flush := func() {
+		if currentStart < 0 {
+			return
+		}
+		content := c.linesToString(currentStart, currentEnd)
+		chunks = append(chunks, Chunk{
+			Content:   content,
+			StartLine: currentStart + 1,
+			EndLine:   currentEnd + 1,
+			Type:      "text",
+			Context:   c.extractContext(content),
+		})
+		currentStart, currentEnd = -1, -1
+		currentTokens = 0
+	}
+
+	total := c.LineCount()
+	for start := 0; start < total; {
+		from := start
+		for start < total && strings.TrimSpace(c.sourceLines[start]) == "" {
+			start++
+		}
+		if start == total {
+			break
+		}
+		end := start
+		for end+1 < total && strings.TrimSpace(c.sourceLines[end+1]) != "" {
+			end++
+		}
+		sep := end
+		for sep+1 < total && strings.TrimSpace(c.sourceLines[sep+1]) == "" {
+			sep++
+		}
+
+		tokens := c.linesTokens(start, end)
+		switch {
+		case tokens > c.maxTokens:
+			flush()
+			pieces := c.splitSentences(c.linesToString(from, end), from)
+			last := &pieces[len(pieces)-1]
+			last.Content += strings.Repeat("\n", sep-end)
+			last.EndLine = sep + 1
+			if last.EndByte != 0 {
+				last.EndByte += sep - end
+			}
+			chunks = append(chunks, pieces...)
+		case currentTokens+tokens > c.maxTokens:
+			flush()
+			fallthrough
+		default:
+			if currentStart < 0 {
+				currentStart = from
+			}
+			currentEnd = sep
+			currentTokens += tokens
+		}
+		start = sep + 1
+	}
+	flush()
+
+	c.finalizeChunks(chunks)
+	return chunks, nil
+}
+
+// splitSentences packs the sentences of a paragraph starting at 0-based line
+// startLine into chunks of at most maxTokens. A sentence ends at ". ", "! "
+// or "? " (or the same followed by a line break). Pieces that start or end
+// mid-line share that line, told apart by StartByte/EndByte as the pieces of
+// a long line are.
+func (c *Chunker) splitSentences(paragraph string, startLine int) []Chunk {
+	var chunks []Chunk
+	maxChars := c.maxChars()
+	offset := c.lineOffset(startLine)
+	pieceStart, lastBreak := 0, 0
+
+	emit := func(end int) {
+		content := strings.TrimSuffix(paragraph[pieceStart:end], "\n")
+		first := startLine + strings.Count(paragraph[:pieceStart], "\n")
+		piece := Chunk{
+			Content:   content,
+			StartLine: first + 1,
+			EndLine:   first + strings.Count(content, "\n") + 1,
+			Type:      "text",
+			Context:   c.extractContext(content),
+		}
+		midStart := pieceStart > 0 && paragraph[pieceStart-1] != '\n'
+		midEnd := end < len(paragraph) && paragraph[end-1] != '\n'
+		if midStart || midEnd {
+			piece.StartByte = offset + pieceStart
+			piece.EndByte = offset + pieceStart + len(content)
+		}
+		chunks = append(chunks, piece)
+	}
+
+	for i := 0; i < len(paragraph); i++ {
+		if i-pieceStart >= maxChars && lastBreak > pieceStart {
+			emit(lastBreak)
+			pieceStart = lastBreak
+		}
+		switch paragraph[i] {
+		case '.', '!', '?':
+			if i+1 < len(paragraph) && (paragraph[i+1] == ' ' || paragraph[i+1] == '\n') {
+				lastBreak = i + 2
+			}
+		}
+	}
+	emit(len(paragraph))
+	return chunks
+}
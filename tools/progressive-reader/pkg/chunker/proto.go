@@ -0,0 +1,47 @@
+package chunker
+
+import (
+	sitter "github.com/smacker/go-tree-sitter"
+)
+
+// protoSpec descends through oversized messages, so nested messages and
+// enums get chunks of their own.
+var protoSpec = astSpec{
+	targets: map[string]bool{
+		"message": true,
+		"enum":    true,
+		"service": true,
+		"rpc":     true,
+		"option":  true,
+	},
+	typeName: extractProtoNodeType,
+	nodeName: extractProtoNodeName,
+	descend:  true,
+}
+
+func (c *Chunker) chunkProto(tree *sitter.Tree) ([]Chunk, error) {
+	return c.chunkAST(tree, protoSpec)
+}
+
+func extractProtoNodeType(nodeType string) string {
+	switch nodeType {
+	case "message", "enum", "service", "rpc", "option":
+		return nodeType
+	default:
+		return "code"
+	}
+}
+
+// extractProtoNodeName reads the name node the grammar wraps each
+// declaration's identifier in (message_name, service_name, ...), or an
+// option's identifier.
+func extractProtoNodeName(node *sitter.Node, source string) string {
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		child := node.NamedChild(i)
+		switch child.Type() {
+		case "message_name", "enum_name", "service_name", "rpc_name", "identifier", "full_ident":
+			return source[child.StartByte():child.EndByte()]
+		}
+	}
+	return ""
+}
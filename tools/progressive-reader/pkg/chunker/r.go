@@ -0,0 +1,55 @@
+package chunker
+
+import (
+	"regexp"
+	"strings"
+)
+
+// rAssignment matches an unindented assignment, capturing the name assigned
+// to, which may be backquoted, and the text after the operator.
+var rAssignment = regexp.MustCompile("^(`[^`]+`|[A-Za-z.][\\w.]*)\\s*(?:<<-|<-|=)([^=].*|)$")
+
+// chunkR splits an R script at its top-level assignments: functions
+// (`foo <- function(x) {...}`, or `\(x)` lambdas) are chunked on their own
+// and runs of other assignments share a chunk. There is no tree-sitter
+// grammar for R, so assignments are found by line: they start unindented,
+// and the `#` or roxygen `#'` comments directly above one belong to it.
+// Other top-level code, such as calls, stays with the assignment before it;
+// library() and source() calls at the top form the preamble.
+func (c *Chunker) chunkR() ([]Chunk, error) {
+	var decls []lineSection
+	lead := -1 // first line of the comments above the next declaration
+	for i, line := range c.sourceLines {
+		trimmed := strings.TrimSpace(line)
+		switch {
+		case trimmed == "" || line[0] == ' ' || line[0] == '\t' || strings.HasPrefix(trimmed, "#!"):
+			lead = -1
+		case strings.HasPrefix(trimmed, "#"):
+			if lead < 0 {
+				lead = i
+			}
+		default:
+			if m := rAssignment.FindStringSubmatch(line); m != nil {
+				kind, name := "variable", strings.Trim(m[1], "`")
+				value := strings.TrimSpace(m[2])
+				if strings.HasPrefix(value, "function") || strings.HasPrefix(value, `\(`) {
+					kind = "function"
+				}
+				start := i
+				if lead >= 0 {
+					start = lead
+				}
+				// Consecutive plain assignments stay together
+				if prev := len(decls) - 1; kind == "variable" && prev >= 0 && decls[prev].kind == "variable" && lead < 0 {
+					continue
+				}
+				decls = append(decls, lineSection{start: start, kind: kind, name: name})
+			}
+			lead = -1
+		}
+	}
+	if len(decls) == 0 {
+		return c.chunkFallback()
+	}
+	return c.chunkSections(decls), nil
+}
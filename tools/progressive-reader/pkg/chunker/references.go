@@ -0,0 +1,60 @@
+package chunker
+
+import (
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+)
+
+// maxReferences caps Chunk.References, keeping the first calls in source
+// order.
+const maxReferences = 20
+
+// Call node types, mapped to the field holding the callee, for astSpec.calls.
+var (
+	callExpressionCalls = map[string]string{"call_expression": "function"}
+	pythonCalls         = map[string]string{"call": "function"}
+	csharpCalls         = map[string]string{"invocation_expression": "function"}
+)
+
+// extractReferences lists the callees of the calls that start within the
+// 0-based rows from..to, such as "fmt.Println" or "save", deduplicated and
+// in source order. Callees that aren't a plain (dotted) name, like
+// `getHandler()(req)`, are skipped.
+func extractReferences(root *sitter.Node, from, to int, source string, calls map[string]string) []string {
+	if calls == nil {
+		return nil
+	}
+
+	var refs []string
+	var walk func(n *sitter.Node)
+	walk = func(n *sitter.Node) {
+		if len(refs) >= maxReferences || int(n.EndPoint().Row) < from || int(n.StartPoint().Row) > to {
+			return
+		}
+		if field, ok := calls[n.Type()]; ok && int(n.StartPoint().Row) >= from {
+			if name := calleeName(n, field, source); name != "" && !contains(refs, name) {
+				refs = append(refs, name)
+			}
+		}
+		for i := 0; i < int(n.NamedChildCount()); i++ {
+			walk(n.NamedChild(i))
+		}
+	}
+	walk(root)
+	return refs
+}
+
+// calleeName returns the source text of a call's callee if it is a plain or
+// dotted name.
+func calleeName(call *sitter.Node, field, source string) string {
+	callee := call.ChildByFieldName(field)
+	if callee == nil {
+		return ""
+	}
+	name := source[callee.StartByte():callee.EndByte()]
+	if name == "" || strings.ContainsAny(name, "()[]{}<>\"'` \t\n") {
+		return ""
+	}
+	return name
+}
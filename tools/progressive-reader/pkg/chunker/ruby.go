@@ -0,0 +1,49 @@
+package chunker
+
+import (
+	sitter "github.com/smacker/go-tree-sitter"
+)
+
+var rubySpec = astSpec{
+	targets: map[string]bool{
+		"class":            true,
+		"module":           true,
+		"method":           true,
+		"singleton_method": true,
+	},
+	typeName: extractRubyNodeType,
+	nodeName: extractRubyNodeName,
+	descend:  true,
+}
+
+func (c *Chunker) chunkRuby(tree *sitter.Tree) ([]Chunk, error) {
+	return c.chunkAST(tree, rubySpec)
+}
+
+func extractRubyNodeType(nodeType string) string {
+	switch nodeType {
+	case "class":
+		return "class"
+	case "module":
+		return "module"
+	case "method":
+		return "method"
+	case "singleton_method":
+		return "singleton_method"
+	default:
+		return "code"
+	}
+}
+
+// extractRubyNodeName reads class and module names, which are constant or
+// scope_resolution nodes (Foo::Bar), and prefixes singleton methods with their
+// receiver so `def self.build` is named "self.build".
+func extractRubyNodeName(node *sitter.Node, source string) string {
+	name := extractFieldName(node, source)
+	if node.Type() == "singleton_method" && name != "" {
+		if object := node.ChildByFieldName("object"); object != nil {
+			return source[object.StartByte():object.EndByte()] + "." + name
+		}
+	}
+	return name
+}
@@ -0,0 +1,55 @@
+package chunker
+
+import (
+	sitter "github.com/smacker/go-tree-sitter"
+)
+
+// Oversized classes, objects and traits descend into their members, which
+// are the same definitions nested in a template_body.
+var scalaSpec = astSpec{
+	targets: map[string]bool{
+		"class_definition":    true,
+		"object_definition":   true,
+		"trait_definition":    true,
+		"function_definition": true,
+		"val_definition":      true,
+	},
+	typeName: extractScalaNodeType,
+	nodeName: extractScalaNodeName,
+	descend:  true,
+}
+
+func (c *Chunker) chunkScala(tree *sitter.Tree) ([]Chunk, error) {
+	return c.chunkAST(tree, scalaSpec)
+}
+
+func extractScalaNodeType(nodeType string) string {
+	switch nodeType {
+	case "class_definition":
+		return "class"
+	case "object_definition":
+		return "object"
+	case "trait_definition":
+		return "trait"
+	case "function_definition":
+		return "function"
+	case "val_definition":
+		return "val"
+	default:
+		return "code"
+	}
+}
+
+// extractScalaNodeName names definitions by their name field, and vals by
+// their pattern, which is usually a plain identifier but may destructure a
+// tuple.
+func extractScalaNodeName(node *sitter.Node, source string) string {
+	if node.Type() != "val_definition" {
+		return extractFieldName(node, source)
+	}
+	pattern := node.ChildByFieldName("pattern")
+	if pattern == nil {
+		return ""
+	}
+	return source[pattern.StartByte():pattern.EndByte()]
+}
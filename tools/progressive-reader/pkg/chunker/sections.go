@@ -0,0 +1,29 @@
+package chunker
+
+import "strings"
+
+// lineSection is a run of lines in a file chunked without tree-sitter,
+// starting at a declaration such as a Dockerfile stage or a GraphQL type.
+type lineSection struct {
+	start int // 0-based first line, including any comments that lead in
+	kind  string
+	name  string
+}
+
+// chunkSections gives each section the lines from its start up to the next
+// section, split by lines if it exceeds maxTokens. Lines before the first
+// section form a "preamble" chunk unless they are blank.
+func (c *Chunker) chunkSections(sections []lineSection) []Chunk {
+	var chunks []Chunk
+	if preamble := c.linesToString(0, sections[0].start-1); strings.TrimSpace(preamble) != "" {
+		chunks = append(chunks, c.splitLineRange(0, sections[0].start-1, "preamble", "")...)
+	}
+	for i, s := range sections {
+		end := c.LineCount() - 1
+		if i+1 < len(sections) {
+			end = sections[i+1].start - 1
+		}
+		chunks = append(chunks, c.splitLineRange(s.start, end, s.kind, s.name)...)
+	}
+	return chunks
+}
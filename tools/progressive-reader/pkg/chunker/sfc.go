@@ -0,0 +1,240 @@
+package chunker
+
+import (
+	"regexp"
+	"strings"
+)
+
+// sfcOpenTag matches a top-level block opening tag in a single-file
+// component, e.g. `<script setup lang="ts">`.
+var sfcOpenTag = regexp.MustCompile(`^<([a-zA-Z][\w-]*)(\s[^>]*)?>`)
+
+// sfcLang extracts the lang attribute of a block's opening tag.
+var sfcLang = regexp.MustCompile(`\blang=["']?(\w+)`)
+
+// sfcBlock is a top-level block of a single-file component. Blocks start
+// and end with tags at column 0, as Vue and Svelte formatters write them.
+type sfcBlock struct {
+	tag   string
+	lang  string
+	start int // 0-based line of the opening tag
+	end   int // 0-based line of the closing tag
+}
+
+// findSFCBlocks returns the top-level blocks of a single-file component.
+// Each block runs from its opening tag to the last closing tag of the same
+// name at column 0, so nested <template> tags don't end it early.
+func findSFCBlocks(lines []string) []sfcBlock {
+	var blocks []sfcBlock
+	for i := 0; i < len(lines); i++ {
+		m := sfcOpenTag.FindStringSubmatch(lines[i])
+		if m == nil {
+			continue
+		}
+		block := sfcBlock{tag: m[1], start: i, end: -1}
+		if lang := sfcLang.FindStringSubmatch(m[2]); lang != nil {
+			block.lang = lang[1]
+		}
+
+		closing := "</" + block.tag + ">"
+		if strings.Contains(lines[i], closing) {
+			block.end = i
+		} else {
+			for j := i + 1; j < len(lines); j++ {
+				if strings.HasPrefix(lines[j], closing) {
+					block.end = j
+				} else if sfcOpenTag.MatchString(lines[j]) && block.end >= 0 {
+					break
+				}
+			}
+		}
+		if block.end < 0 {
+			continue
+		}
+		blocks = append(blocks, block)
+		i = block.end
+	}
+	return blocks
+}
+
+// chunkVue splits a Vue single-file component at its top-level blocks. The
+// <script> and <style> contents are chunked by the TS/JS and CSS chunkers;
+// <template> and custom blocks are kept whole within the token budget.
+func (c *Chunker) chunkVue() ([]Chunk, error) {
+	var chunks []Chunk
+	for _, block := range findSFCBlocks(c.sourceLines) {
+		blockChunks, err := c.chunkSFCBlock(block)
+		if err != nil {
+			return nil, err
+		}
+		chunks = append(chunks, blockChunks...)
+	}
+	chunks = c.coverSFCLines(chunks)
+
+	c.finalizeChunks(chunks)
+	return chunks, nil
+}
+
+// chunkSvelte splits a Svelte component into its <script> and <style>
+// blocks, chunked by the TS/JS and CSS chunkers, and the markup between them,
+// which is kept in line runs within the token budget.
+func (c *Chunker) chunkSvelte() ([]Chunk, error) {
+	var chunks []Chunk
+	markupStart := 0
+	emitMarkup := func(end int) {
+		for markupStart <= end && strings.TrimSpace(c.sourceLines[markupStart]) == "" {
+			markupStart++
+		}
+		for end >= markupStart && strings.TrimSpace(c.sourceLines[end]) == "" {
+			end--
+		}
+		if markupStart <= end {
+			chunks = append(chunks, c.splitLineRange(markupStart, end, "markup", "markup")...)
+		}
+	}
+
+	for _, block := range findSFCBlocks(c.sourceLines) {
+		if block.tag != "script" && block.tag != "style" {
+			continue
+		}
+		emitMarkup(block.start - 1)
+		blockChunks, err := c.chunkSFCBlock(block)
+		if err != nil {
+			return nil, err
+		}
+		chunks = append(chunks, blockChunks...)
+		markupStart = block.end + 1
+	}
+	emitMarkup(c.LineCount() - 1)
+	chunks = c.coverSFCLines(chunks)
+
+	c.finalizeChunks(chunks)
+	return chunks, nil
+}
+
+// chunkSFCBlock chunks one block. Script and style contents are handed to the
+// chunker for their language and renumbered to file lines; each chunk is
+// named "<block> > <symbol>", or just "<block>" when the symbol is unnamed.
+func (c *Chunker) chunkSFCBlock(block sfcBlock) ([]Chunk, error) {
+	ext := ""
+	switch block.tag {
+	case "script":
+		ext = ".js"
+		if block.lang == "ts" || block.lang == "typescript" {
+			ext = ".ts"
+		}
+	case "style":
+		ext = ".css"
+		if block.lang == "scss" || block.lang == "less" {
+			ext = "." + block.lang
+		}
+	}
+	if ext == "" || block.end-block.start < 2 {
+		return c.splitLineRange(block.start, block.end, block.tag, block.tag), nil
+	}
+
+	// The file's own line markers are added to these chunks afterwards, and
+	// they are dedented along with the block's tags. Progress is the file's,
+	// not the block's
+	opts := c.opts
+	opts.Overview, opts.LineMarkers, opts.Dedent = false, false, false
+	opts.OnProgress = nil
+	inner := []byte(c.linesToString(block.start+1, block.end-1))
+	sub, err := NewChunkerWithOptions(c.filePath+ext, inner, opts)
+	if err != nil {
+		return nil, err
+	}
+	chunks, err := sub.ChunkFile()
+	if err != nil {
+		return nil, err
+	}
+
+	innerOffset := c.lineOffset(block.start + 1)
+	for i := range chunks {
+		chunks[i].StartLine += block.start + 1
+		chunks[i].EndLine += block.start + 1
+		if chunks[i].EndByte > 0 {
+			chunks[i].StartByte += innerOffset
+			chunks[i].EndByte += innerOffset
+		}
+		if chunks[i].Name == "" {
+			chunks[i].Name = block.tag
+		} else {
+			chunks[i].Name = block.tag + " > " + chunks[i].Name
+		}
+	}
+
+	// The opening and closing tags go with the block's first and last chunks
+	if len(chunks) > 0 {
+		if first := &chunks[0]; first.EndByte == 0 {
+			first.Content = c.linesToString(block.start, first.StartLine-2) + "\n" + first.Content
+			first.StartLine = block.start + 1
+		}
+		if last := &chunks[len(chunks)-1]; last.EndByte == 0 {
+			last.Content += "\n" + c.linesToString(last.EndLine, block.end)
+			last.EndLine = block.end + 1
+		}
+	}
+	return chunks, nil
+}
+
+// coverSFCLines gives the lines between blocks, which no chunk covers, to
+// the chunk after them, and the lines after the last block to the last
+// chunk. Where that chunk is a piece of a long line they form a "text"
+// chunk of their own instead.
+func (c *Chunker) coverSFCLines(chunks []Chunk) []Chunk {
+	var covered []Chunk
+	next := 0 // first 0-based line no chunk covers yet
+	for _, ch := range chunks {
+		if start := ch.StartLine - 1; start > next {
+			if ch.EndByte == 0 {
+				ch.Content = c.linesToString(next, start-1) + "\n" + ch.Content
+				ch.StartLine = next + 1
+			} else {
+				covered = append(covered, c.splitLineRange(next, start-1, "text", "")...)
+			}
+		}
+		covered = append(covered, ch)
+		next = max(next, ch.EndLine)
+	}
+	if last := c.LineCount() - 1; next <= last {
+		if n := len(covered); n > 0 && covered[n-1].EndByte == 0 {
+			covered[n-1].Content += "\n" + c.linesToString(next, last)
+			covered[n-1].EndLine = last + 1
+		} else {
+			covered = append(covered, c.splitLineRange(next, last, "text", "")...)
+		}
+	}
+	return covered
+}
+
+// splitLineRange chunks the 0-based lines start..end as consecutive line runs
+// of at most maxTokens each. Every piece has the given type; pieces after the
+// first are named "<name> (cont.)".
+func (c *Chunker) splitLineRange(start, end int, chunkType, name string) []Chunk {
+	var chunks []Chunk
+	maxChars := c.maxChars()
+	for from := start; from <= end; {
+		to, size := from, len(c.sourceLines[from])
+		for to+1 <= end && size+1+len(c.sourceLines[to+1]) <= maxChars {
+			to++
+			size += 1 + len(c.sourceLines[to])
+		}
+
+		content := c.linesToString(from, to)
+		piece := Chunk{
+			Content:   content,
+			StartLine: from + 1,
+			EndLine:   to + 1,
+			Type:      chunkType,
+			Name:      name,
+			Context:   c.extractContext(content),
+		}
+		if from > start && name != "" {
+			piece.Name = name + " (cont.)"
+		}
+		chunks = append(chunks, piece)
+		from = to + 1
+	}
+	return chunks
+}
@@ -0,0 +1,52 @@
+package chunker
+
+import (
+	sitter "github.com/smacker/go-tree-sitter"
+)
+
+// Each statement (CREATE, ALTER, INSERT, ...) stays whole so a migration can
+// be read one step at a time.
+var sqlSpec = astSpec{
+	targets: map[string]bool{
+		"statement": true,
+	},
+	typeName: extractSQLNodeType,
+	kindName: extractSQLStatementKind,
+	nodeName: extractSQLNodeName,
+	descend:  true,
+}
+
+func (c *Chunker) chunkSQL(tree *sitter.Tree) ([]Chunk, error) {
+	return c.chunkAST(tree, sqlSpec)
+}
+
+func extractSQLNodeType(nodeType string) string {
+	if nodeType == "statement" {
+		return "statement"
+	}
+	return "code"
+}
+
+// extractSQLStatementKind labels a statement by its form, e.g. "create_table",
+// "alter_table" or "insert".
+func extractSQLStatementKind(node *sitter.Node, source string) string {
+	if node.Type() != "statement" || node.NamedChildCount() == 0 {
+		return ""
+	}
+	return node.NamedChild(0).Type()
+}
+
+// extractSQLNodeName returns the first table or object the statement
+// references.
+func extractSQLNodeName(node *sitter.Node, source string) string {
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		child := node.NamedChild(i)
+		if child.Type() == "object_reference" {
+			return source[child.StartByte():child.EndByte()]
+		}
+		if name := extractSQLNodeName(child, source); name != "" {
+			return name
+		}
+	}
+	return ""
+}
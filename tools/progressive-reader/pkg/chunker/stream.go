@@ -0,0 +1,217 @@
+package chunker
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"unicode/utf8"
+
+	"github.com/arpitnath/super-claude-kit/tools/progressive-reader/pkg/parser"
+)
+
+// ChunkReader returns an iterator that chunks the text read from r without
+// holding the whole file in memory, for logs and data files too large to
+// pass to NewChunker. Lines are packed into "text" chunks within
+// opts.MaxTokens, MaxLines and MaxBytes; a longer line is split into pieces
+// carrying StartByte/EndByte. About one chunk of input is held at a time, so
+// TotalChunks is 0 on every chunk, and options that need the whole file
+// (MaxChunks, Dedupe, Overview, LineMarkers, OnProgress) are ignored.
+// Identical chunks get distinct StableIDs only within a window of
+// streamIDWindow chunks.
+//
+// Only languages chunked without tree-sitter can be streamed: for one
+// parsed with a grammar, which needs the whole file, ChunkReader returns
+// ErrUnsupportedLanguage.
+func ChunkReader(filePath string, r io.Reader, opts Options) (*ChunkIterator, error) {
+	p, err := parser.NewParser(filePath)
+	if err != nil {
+		return nil, newFileError(filePath, parser.DetectLanguage(filePath), err)
+	}
+	if p.HasGrammar() {
+		return nil, newFileError(filePath, p.GetLanguage(),
+			fmt.Errorf("%w: %s can't be streamed, it is parsed from the whole file", ErrUnsupportedLanguage, p.GetLanguage()))
+	}
+	c, err := NewChunkerWithParser(p, filePath, nil, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	size := c.streamChars() + 1
+	if size < binarySniffLen+1 {
+		size = binarySniffLen + 1
+	}
+	return &ChunkIterator{
+		chunker: c,
+		started: true,
+		stream: &textStream{
+			c:        c,
+			r:        bufio.NewReaderSize(r, size),
+			seen:     make(map[string]int),
+			testFile: isTestFile(filePath),
+		},
+	}, nil
+}
+
+// streamIDWindow is how many streamed chunks share one count of the
+// StableIDs handed out, which is then cleared so it doesn't grow with the
+// input.
+const streamIDWindow = 1024
+
+// streamChars is the most bytes of content a streamed chunk holds: the token
+// budget's worth, or Options.MaxBytes if that is less.
+func (c *Chunker) streamChars() int {
+	if c.opts.MaxBytes > 0 {
+		return min(c.maxChars(), c.opts.MaxBytes)
+	}
+	return c.maxChars()
+}
+
+// textStream reads lines from a reader and packs them into chunks, one
+// chunk per call to next.
+type textStream struct {
+	c        *Chunker
+	r        *bufio.Reader
+	line     int    // 0-based line the next segment belongs to
+	offset   int    // byte offset of the next segment in the input
+	pending  []byte // segment read but not yet placed in a chunk
+	eol      bool   // pending ends its line
+	inLine   bool   // the next segment continues a line split into pieces
+	index    int    // CurrentChunk of the next chunk
+	seen     map[string]int
+	testFile bool
+	sniffed  bool
+}
+
+// segment returns the next line, without its newline, or the next piece of
+// at most max bytes of a longer line; eol reports whether it ends the line.
+// ok is false at the end of the input.
+func (s *textStream) segment(max int) (seg []byte, eol, ok bool, err error) {
+	if s.pending != nil {
+		seg, eol = s.pending, s.eol
+		s.pending = nil
+		return seg, eol, true, nil
+	}
+
+	buf, err := s.r.Peek(max + 1)
+	if err != nil && err != io.EOF && err != bufio.ErrBufferFull {
+		return nil, false, false, err
+	}
+	if len(buf) == 0 {
+		return nil, false, false, nil
+	}
+	n, skip := len(buf), 0
+	switch i := bytes.IndexByte(buf, '\n'); {
+	case i >= 0 && i <= max:
+		n, skip, eol = i, 1, true
+	case len(buf) > max:
+		// Back off so no UTF-8 sequence is split, as splitLine does
+		n = max
+		for n > 0 && !utf8.RuneStart(buf[n]) {
+			n--
+		}
+		if n == 0 {
+			n = max
+		}
+	default: // the last line, without a trailing newline
+		eol = true
+	}
+	// A copy, since buf is only valid until the next read; empty lines must
+	// stay non-nil to be told apart from no pending segment
+	seg = make([]byte, n)
+	copy(seg, buf)
+	if _, err := s.r.Discard(n + skip); err != nil {
+		return nil, false, false, err
+	}
+	return seg, eol, true, nil
+}
+
+// unread puts back a segment returned by segment, to start the next chunk.
+func (s *textStream) unread(seg []byte, eol bool) {
+	s.pending, s.eol = seg, eol
+}
+
+// next returns the next chunk, or ok false at the end of the input.
+func (s *textStream) next() (chunk Chunk, ok bool, err error) {
+	if !s.sniffed {
+		s.sniffed = true
+		if head, _ := s.r.Peek(binarySniffLen + 1); looksBinary(head) {
+			return Chunk{}, false, newFileError(s.c.filePath, s.c.parser.GetLanguage(), ErrBinaryFile)
+		}
+	}
+
+	maxChars, maxLines := s.c.streamChars(), s.c.opts.MaxLines
+	var content []byte
+	startLine := s.line
+	for {
+		seg, eol, more, err := s.segment(maxChars)
+		if err != nil {
+			return Chunk{}, false, err
+		}
+		if !more {
+			break
+		}
+
+		// Pieces of an overlong line are chunks of their own
+		if !eol || s.inLine {
+			if s.line > startLine {
+				s.unread(seg, eol)
+				break
+			}
+			chunk = Chunk{
+				Content:   string(seg),
+				StartLine: s.line + 1,
+				EndLine:   s.line + 1,
+				Type:      "text",
+				StartByte: s.offset,
+				EndByte:   s.offset + len(seg),
+			}
+			s.offset += len(seg)
+			s.inLine = !eol
+			if eol {
+				s.offset++
+				s.line++
+			}
+			return s.emit(chunk), true, nil
+		}
+
+		full := len(content)+1+len(seg) > maxChars || (maxLines > 0 && s.line-startLine >= maxLines)
+		if s.line > startLine && full {
+			s.unread(seg, eol)
+			break
+		}
+		if s.line > startLine {
+			content = append(content, '\n')
+		}
+		content = append(content, seg...)
+		s.offset += len(seg) + 1
+		s.line++
+	}
+	if s.line == startLine {
+		return Chunk{}, false, nil
+	}
+
+	return s.emit(Chunk{
+		Content:   string(content),
+		StartLine: startLine + 1,
+		EndLine:   s.line,
+		Type:      "text",
+	}), true, nil
+}
+
+// emit finalizes and numbers a chunk about to be returned by next.
+func (s *textStream) emit(chunk Chunk) Chunk {
+	chunk.Context = s.c.extractContext(chunk.Content)
+	s.c.finalizeChunk(&chunk, s.seen, s.testFile)
+	chunk.CurrentChunk = s.index
+	s.index++
+	if s.index%streamIDWindow == 0 {
+		clear(s.seen)
+	}
+	if s.pending != nil {
+		chunk.HasMore = true
+	} else if next, _ := s.r.Peek(1); len(next) > 0 {
+		chunk.HasMore = true
+	}
+	return chunk
+}
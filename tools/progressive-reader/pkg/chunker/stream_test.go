@@ -0,0 +1,150 @@
+package chunker
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+// logReader yields n numbered log lines of about 60 bytes each, generating
+// them as they are read.
+type logReader struct {
+	n, line int
+	buf     []byte
+}
+
+func (r *logReader) Read(p []byte) (int, error) {
+	if len(r.buf) == 0 {
+		if r.line == r.n {
+			return 0, io.EOF
+		}
+		r.line++
+		r.buf = []byte(fmt.Sprintf("2024-01-01T00:00:00Z INFO request %d served in %dms\n", r.line, r.line%500))
+	}
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
+
+func TestChunkReaderMemoryCeiling(t *testing.T) {
+	// About 64 MB of input
+	const lines = 1 << 20
+	const ceiling = 16 << 20
+
+	it, err := ChunkReader("server.log", &logReader{n: lines}, Options{MaxTokens: 2000})
+	if err != nil {
+		t.Fatal(err)
+	}
+	runtime.GC()
+	var stats runtime.MemStats
+	runtime.ReadMemStats(&stats)
+	base := stats.HeapAlloc
+
+	last, peak := 0, uint64(0)
+	for n := 0; ; n++ {
+		chunk, ok := it.Next()
+		if !ok {
+			break
+		}
+		last = chunk.EndLine
+		if n%500 == 0 {
+			runtime.ReadMemStats(&stats)
+			if stats.HeapAlloc > base {
+				peak = max(peak, stats.HeapAlloc-base)
+			}
+		}
+	}
+	if err := it.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if last != lines {
+		t.Errorf("last chunk ends on line %d, want %d", last, lines)
+	}
+	if peak > ceiling {
+		t.Errorf("heap grew by %d bytes streaming %d lines, want at most %d", peak, lines, ceiling)
+	}
+}
+
+func TestChunkReaderCaps(t *testing.T) {
+	opts := Options{MaxTokens: 2000, MaxLines: 7, MaxBytes: 300}
+	it, err := ChunkReader("server.log", &logReader{n: 1000}, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for {
+		chunk, ok := it.Next()
+		if !ok {
+			break
+		}
+		if lines := strings.Count(chunk.Content, "\n") + 1; lines > opts.MaxLines {
+			t.Fatalf("chunk %d holds %d lines, want at most %d", chunk.CurrentChunk, lines, opts.MaxLines)
+		}
+		if len(chunk.Content) > opts.MaxBytes {
+			t.Fatalf("chunk %d holds %d bytes, want at most %d", chunk.CurrentChunk, len(chunk.Content), opts.MaxBytes)
+		}
+	}
+	if err := it.Err(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestChunkReaderRejectsASTLanguages(t *testing.T) {
+	for _, path := range []string{"main.go", "app.tsx", "schema.sql"} {
+		if _, err := ChunkReader(path, strings.NewReader("x\n"), Options{}); !errors.Is(err, ErrUnsupportedLanguage) {
+			t.Errorf("ChunkReader(%q) error = %v, want ErrUnsupportedLanguage", path, err)
+		}
+	}
+	for _, path := range []string{"server.log", "README.md", "Dockerfile"} {
+		if _, err := ChunkReader(path, strings.NewReader("x\n"), Options{}); err != nil {
+			t.Errorf("ChunkReader(%q) error = %v, want nil", path, err)
+		}
+	}
+}
+
+func TestChunkReaderBoundsStableIDCounts(t *testing.T) {
+	// Identical lines make identical chunks, one per line
+	input := strings.Repeat("same line\n", 3*streamIDWindow)
+	it, err := ChunkReader("server.log", strings.NewReader(input), Options{MaxTokens: 5})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ids := map[string]bool{}
+	for n := 0; ; n++ {
+		chunk, ok := it.Next()
+		if !ok {
+			break
+		}
+		if n < streamIDWindow && ids[chunk.StableID] {
+			t.Fatalf("chunk %d repeats StableID %s within the first window", n, chunk.StableID)
+		}
+		ids[chunk.StableID] = true
+		if size := len(it.stream.seen); size > streamIDWindow {
+			t.Fatalf("StableID counts hold %d entries after chunk %d, want at most %d", size, n, streamIDWindow)
+		}
+	}
+	if err := it.Err(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// BenchmarkChunkReader streams about 16 MB of log lines.
+func BenchmarkChunkReader(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		it, err := ChunkReader("server.log", &logReader{n: 1 << 18}, Options{MaxTokens: 2000})
+		if err != nil {
+			b.Fatal(err)
+		}
+		for {
+			if _, ok := it.Next(); !ok {
+				break
+			}
+		}
+		if err := it.Err(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,85 @@
+package chunker
+
+import (
+	"sort"
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+)
+
+// byteRange is a half-open range of byte offsets in the source.
+type byteRange struct {
+	start, end int
+}
+
+// stripComments removes the file's comments from the chunks' Content for
+// Options.StripComments. A line that held only comments is dropped; one that
+// ended in a comment loses the whitespace before it. Comment ranges are byte
+// offsets in the source, so whole lines are cut from the source lines and
+// dedented again as Options.Dedent left them.
+func (c *Chunker) stripComments(chunks []Chunk) {
+	for i := range chunks {
+		chunk := &chunks[i]
+		lines := strings.Split(chunk.Content, "\n")
+		offset := c.lineOffset(chunk.StartLine - 1)
+		if chunk.EndByte != 0 {
+			offset = chunk.StartByte
+		}
+
+		var kept []string
+		for j, line := range lines {
+			// A piece of a long line isn't dedented, and starts at StartByte
+			if n := chunk.StartLine - 1 + j; chunk.EndByte == 0 && n < len(c.sourceLines) {
+				line = c.sourceLines[n]
+			}
+			stripped := cutRanges(line, offset, c.comments)
+			offset += len(line) + 1
+			if stripped != line {
+				if stripped = strings.TrimRight(stripped, " \t"); strings.TrimSpace(stripped) == "" {
+					continue
+				}
+			}
+			if chunk.EndByte == 0 {
+				stripped = strings.TrimPrefix(stripped, chunk.Indent)
+			}
+			kept = append(kept, stripped)
+		}
+		chunk.Content = strings.Join(kept, "\n")
+	}
+}
+
+// commentRanges appends the byte ranges of the comment nodes under n, in
+// source order, to ranges. Grammars name their comment nodes "comment",
+// "line_comment", "block_comment" and so on.
+func commentRanges(n *sitter.Node, ranges []byteRange) []byteRange {
+	if n.IsNamed() && strings.Contains(n.Type(), "comment") {
+		return append(ranges, byteRange{int(n.StartByte()), int(n.EndByte())})
+	}
+	for i := 0; i < int(n.ChildCount()); i++ {
+		if child := n.Child(i); child != nil {
+			ranges = commentRanges(child, ranges)
+		}
+	}
+	return ranges
+}
+
+// cutRanges returns line, which starts at byte offset in the source, with
+// the parts covered by ranges removed.
+func cutRanges(line string, offset int, ranges []byteRange) string {
+	end := offset + len(line)
+	i := sort.Search(len(ranges), func(i int) bool { return ranges[i].end > offset })
+	if i == len(ranges) || ranges[i].start >= end {
+		return line
+	}
+
+	var b strings.Builder
+	pos := offset
+	for ; i < len(ranges) && ranges[i].start < end; i++ {
+		if ranges[i].start > pos {
+			b.WriteString(line[pos-offset : ranges[i].start-offset])
+		}
+		pos = max(pos, min(ranges[i].end, end))
+	}
+	b.WriteString(line[pos-offset:])
+	return b.String()
+}
@@ -0,0 +1,41 @@
+package chunker
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestStripComments(t *testing.T) {
+	source := "class A:\n    def f(self):\n        # leading comment\n        x = 1  # trailing comment\n        return x\n"
+	tests := []struct {
+		name string
+		opts Options
+		want map[int]string // Content by StartLine
+	}{
+		{"off", Options{}, map[int]string{1: strings.TrimSuffix(source, "\n")}},
+		{"whole file", Options{StripComments: true}, map[int]string{1: "class A:\n    def f(self):\n        x = 1\n        return x"}},
+		{"dedented", Options{MaxTokens: 10, Dedent: true, StripComments: true}, map[int]string{4: "x = 1", 5: "return x"}},
+		{"line capped", Options{MaxLines: 2, StripComments: true}, map[int]string{3: "        x = 1", 5: "        return x"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := NewChunkerWithOptions("a.py", []byte(source), tt.opts)
+			if err != nil {
+				t.Fatal(err)
+			}
+			chunks, err := c.ChunkFile()
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := make(map[int]string)
+			for _, ch := range chunks {
+				got[ch.StartLine] = ch.Content
+			}
+			for line, want := range tt.want {
+				if got[line] != want {
+					t.Errorf("chunk on line %d = %q, want %q", line, got[line], want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package chunker
+
+import (
+	sitter "github.com/smacker/go-tree-sitter"
+)
+
+// The Swift grammar uses class_declaration for classes, structs, enums,
+// actors and extensions alike; the keyword is kept in its declaration_kind
+// field. Oversized extensions descend into their member functions.
+var swiftSpec = astSpec{
+	targets: map[string]bool{
+		"class_declaration":    true,
+		"protocol_declaration": true,
+		"function_declaration": true,
+		"init_declaration":     true,
+	},
+	typeName: extractSwiftNodeType,
+	kindName: extractSwiftDeclarationKind,
+	nodeName: extractFieldName,
+	descend:  true,
+}
+
+func (c *Chunker) chunkSwift(tree *sitter.Tree) ([]Chunk, error) {
+	return c.chunkAST(tree, swiftSpec)
+}
+
+func extractSwiftNodeType(nodeType string) string {
+	switch nodeType {
+	case "class_declaration":
+		return "class"
+	case "protocol_declaration":
+		return "protocol"
+	case "function_declaration":
+		return "function"
+	case "init_declaration":
+		return "init"
+	default:
+		return "code"
+	}
+}
+
+// extractSwiftDeclarationKind returns "struct", "enum", "extension", etc. for
+// class_declaration nodes, and "" for everything else.
+func extractSwiftDeclarationKind(node *sitter.Node, source string) string {
+	if node.Type() != "class_declaration" {
+		return ""
+	}
+	kind := node.ChildByFieldName("declaration_kind")
+	if kind == nil {
+		return ""
+	}
+	return source[kind.StartByte():kind.EndByte()]
+}
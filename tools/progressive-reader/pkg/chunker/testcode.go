@@ -0,0 +1,39 @@
+package chunker
+
+import (
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// testFilePattern matches the file names test runners pick up: Go's
+// _test.go, Jest/Vitest .test/.spec files and pytest's test_*.py/*_test.py.
+var testFilePattern = regexp.MustCompile(`(_test\.go|\.(test|spec)\.[jt]sx?|^test_.*\.py|_test\.py)$`)
+
+// testDeclPattern matches the header of a test declaration: Go test,
+// benchmark, fuzz and example functions, pytest functions and classes, and
+// Jest/Mocha describe/it/test blocks.
+var testDeclPattern = regexp.MustCompile(`^(func (Test|Benchmark|Fuzz|Example)([^a-z]\w*)?\(|(async )?def test(_\w*)?\(|class Test\w*|(describe|it|test)(\.\w+)?\()`)
+
+// isTestFile reports whether filePath is named like a test file.
+func isTestFile(filePath string) bool {
+	return testFilePattern.MatchString(filepath.Base(filePath))
+}
+
+// isTestChunk reports whether a chunk is declared as a test, judged by its
+// type for languages with dedicated test blocks (Zig), its signature, or, for
+// chunks without one, its first non-blank line.
+func isTestChunk(chunk Chunk) bool {
+	if chunk.Type == "test" {
+		return true
+	}
+	header := chunk.Signature
+	if header == "" {
+		for _, line := range strings.Split(chunk.Content, "\n") {
+			if header = strings.TrimSpace(line); header != "" {
+				break
+			}
+		}
+	}
+	return testDeclPattern.MatchString(header)
+}
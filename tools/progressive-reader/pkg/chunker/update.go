@@ -0,0 +1,90 @@
+package chunker
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+)
+
+// InputEdit describes a single edit to a file as byte offsets: the bytes
+// StartByte..OldEndByte of the old source were replaced by the bytes
+// StartByte..NewEndByte of the new one. An insertion has OldEndByte ==
+// StartByte, a deletion NewEndByte == StartByte.
+type InputEdit struct {
+	StartByte  int
+	OldEndByte int
+	NewEndByte int
+}
+
+// Update re-chunks the file after an edit, re-parsing only the edited region.
+// It returns the chunks that changed and the StableIDs of those that didn't.
+func (c *Chunker) Update(edit InputEdit, newSource []byte) (changed []Chunk, unchanged []string, err error) {
+	lang := c.parser.GetLanguage()
+	if edit.StartByte < 0 || edit.OldEndByte < edit.StartByte || edit.OldEndByte > len(c.sourceCode) ||
+		edit.NewEndByte < edit.StartByte || edit.NewEndByte > len(newSource) {
+		return nil, nil, newFileError(c.filePath, lang,
+			fmt.Errorf("%w: bytes %d-%d replaced by %d-%d", ErrInvalidEdit, edit.StartByte, edit.OldEndByte, edit.StartByte, edit.NewEndByte))
+	}
+
+	if c.parser.HasGrammar() && c.tree == nil {
+		if c.tree, err = c.parser.Parse(c.sourceCode); err != nil {
+			return nil, nil, newFileError(c.filePath, lang, err)
+		}
+	}
+	if c.chunks == nil {
+		if c.chunks, err = c.ChunkFile(); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if c.tree != nil {
+		c.tree.Edit(sitter.EditInput{
+			StartIndex:  uint32(edit.StartByte),
+			OldEndIndex: uint32(edit.OldEndByte),
+			NewEndIndex: uint32(edit.NewEndByte),
+			StartPoint:  pointAt(c.sourceCode, edit.StartByte),
+			OldEndPoint: pointAt(c.sourceCode, edit.OldEndByte),
+			NewEndPoint: pointAt(newSource, edit.NewEndByte),
+		})
+		tree, err := c.parser.Reparse(c.tree, newSource)
+		if err != nil {
+			return nil, nil, newFileError(c.filePath, lang, err)
+		}
+		// The new tree holds its own references to the subtrees it reuses
+		c.tree.Close()
+		c.tree = tree
+	}
+	c.sourceCode = newSource
+	c.sourceLines = strings.Split(string(newSource), "\n")
+
+	chunks, err := c.ChunkFile()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	type position struct{ start, end int }
+	previous := make(map[string]position, len(c.chunks))
+	for _, ch := range c.chunks {
+		previous[ch.StableID] = position{ch.StartLine, ch.EndLine}
+	}
+	for _, ch := range chunks {
+		if pos, ok := previous[ch.StableID]; ok && pos == (position{ch.StartLine, ch.EndLine}) {
+			unchanged = append(unchanged, ch.StableID)
+		} else {
+			changed = append(changed, ch)
+		}
+	}
+	c.chunks = chunks
+	return changed, unchanged, nil
+}
+
+// pointAt returns the row and byte column of offset in source, as tree-sitter
+// expects them in an edit.
+func pointAt(source []byte, offset int) sitter.Point {
+	before := source[:offset]
+	row := bytes.Count(before, []byte("\n"))
+	col := offset - (bytes.LastIndexByte(before, '\n') + 1)
+	return sitter.Point{Row: uint32(row), Column: uint32(col)}
+}
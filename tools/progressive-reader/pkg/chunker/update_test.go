@@ -0,0 +1,83 @@
+package chunker
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestUpdate(t *testing.T) {
+	tests := []struct {
+		name      string
+		from, to  string   // the first from in shapesSource is replaced by to
+		changed   []string // names of the chunks reported changed
+		unchanged []string // names of the chunks whose StableIDs are reported unchanged
+	}{
+		{"no-op", "w * h", "w * h", nil, []string{"Area", "Perimeter", "Diagonal"}},
+		{"body edited", "w * h", "h * w", []string{"Area"}, []string{"Perimeter", "Diagonal"}},
+		{"function appended", "h*h))\n}\n", "h*h))\n}\n\nfunc Volume(w, h, d int) int {\n\treturn w * h * d\n}\n", []string{"Volume"}, []string{"Area", "Perimeter", "Diagonal"}},
+		{"function renamed", "Perimeter", "Circumference", []string{"Circumference"}, []string{"Area", "Diagonal"}},
+		// Chunks that move are reported even if their content didn't change
+		{"blank line inserted above", "package shapes\n", "package shapes\n\n", []string{"Area", "Perimeter", "Diagonal"}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := NewChunkerWithOptions("shapes.go", []byte(shapesSource), Options{MaxTokens: 20})
+			if err != nil {
+				t.Fatal(err)
+			}
+			before, err := c.ChunkFile()
+			if err != nil {
+				t.Fatal(err)
+			}
+			names := map[string]string{} // chunk name by StableID
+			for _, ch := range before {
+				names[ch.StableID] = ch.Name
+			}
+
+			start := strings.Index(shapesSource, tt.from)
+			edit := InputEdit{StartByte: start, OldEndByte: start + len(tt.from), NewEndByte: start + len(tt.to)}
+			changed, unchanged, err := c.Update(edit, []byte(strings.Replace(shapesSource, tt.from, tt.to, 1)))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var gotChanged, gotUnchanged []string
+			for _, ch := range changed {
+				gotChanged = append(gotChanged, ch.Name)
+			}
+			for _, id := range unchanged {
+				gotUnchanged = append(gotUnchanged, names[id])
+			}
+			if !reflect.DeepEqual(gotChanged, tt.changed) {
+				t.Errorf("changed = %q, want %q", gotChanged, tt.changed)
+			}
+			if !reflect.DeepEqual(gotUnchanged, tt.unchanged) {
+				t.Errorf("unchanged = %q, want %q", gotUnchanged, tt.unchanged)
+			}
+		})
+	}
+}
+
+func TestUpdateRejectsInvalidEdits(t *testing.T) {
+	source := []byte(shapesSource)
+	tests := []struct {
+		name string
+		edit InputEdit
+	}{
+		{"negative start", InputEdit{StartByte: -1, OldEndByte: 0, NewEndByte: 0}},
+		{"old end before start", InputEdit{StartByte: 5, OldEndByte: 4, NewEndByte: 5}},
+		{"old end past the old source", InputEdit{StartByte: 0, OldEndByte: len(source) + 1, NewEndByte: 0}},
+		{"new end past the new source", InputEdit{StartByte: 0, OldEndByte: 0, NewEndByte: len(source) + 1}},
+	}
+	for _, tt := range tests {
+		c, err := NewChunkerWithOptions("shapes.go", source, Options{MaxTokens: 20})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, _, err := c.Update(tt.edit, source); !errors.Is(err, ErrInvalidEdit) {
+			t.Errorf("%s: error = %v, want ErrInvalidEdit", tt.name, err)
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package chunker
+
+import (
+	"fmt"
+	"strings"
+)
+
+// maxValidationProblems caps how many problems ValidateChunks describes.
+const maxValidationProblems = 10
+
+// ValidateChunks checks that a file's chunks are ordered, don't overlap,
+// cover lines 1..totalLines, hold a line of Content per line covered, and are
+// numbered consistently. It returns nil or ErrInvalidChunks.
+func ValidateChunks(chunks []Chunk, totalLines int) error {
+	var problems []string
+	report := func(format string, args ...interface{}) {
+		problems = append(problems, fmt.Sprintf(format, args...))
+	}
+
+	covered := make([]bool, totalLines+1)
+	cover := func(i, start, end int) {
+		for line := start; line <= end; line++ {
+			if line < 1 || line > totalLines {
+				report("chunk %d covers line %d outside 1-%d", i, line, totalLines)
+				return
+			}
+			covered[line] = true
+		}
+	}
+
+	prev := -1 // index of the last chunk covering lines
+	for i, ch := range chunks {
+		if ch.CurrentChunk != i || ch.TotalChunks != len(chunks) || ch.HasMore != (i < len(chunks)-1) {
+			report("chunk %d is numbered %d of %d (HasMore %t)", i, ch.CurrentChunk, ch.TotalChunks, ch.HasMore)
+		}
+		if ch.EndLine == 0 {
+			continue
+		}
+		if ch.EndLine < ch.StartLine {
+			report("chunk %d ends on line %d before it starts on line %d", i, ch.EndLine, ch.StartLine)
+			continue
+		}
+
+		if prev >= 0 {
+			p := chunks[prev]
+			samePiece := p.EndByte != 0 && ch.EndByte != 0 && p.EndLine == ch.StartLine && ch.StartByte >= p.EndByte
+			switch {
+			case ch.StartLine < p.StartLine:
+				report("chunk %d starts on line %d, before chunk %d on line %d", i, ch.StartLine, prev, p.StartLine)
+			case ch.StartLine <= p.EndLine && !samePiece:
+				report("chunk %d (lines %d-%d) overlaps chunk %d (lines %d-%d)", i, ch.StartLine, ch.EndLine, prev, p.StartLine, p.EndLine)
+			}
+		}
+		prev = i
+
+		cover(i, ch.StartLine, ch.EndLine)
+		for _, r := range ch.Duplicates {
+			cover(i, r.Start, r.End)
+		}
+		lines := ch.EndLine - ch.StartLine + 1
+		for _, r := range ch.Grouped {
+			cover(i, r.Start, r.End)
+			lines += r.End - r.Start + 1
+		}
+		if content := ch.Unmarked(); content != "" && strings.Count(content, "\n")+1 != lines {
+			report("chunk %d (lines %d-%d) holds %d lines of content", i, ch.StartLine, ch.EndLine, strings.Count(content, "\n")+1)
+		}
+	}
+
+	for line := 1; line <= totalLines; line++ {
+		if covered[line] {
+			continue
+		}
+		end := line
+		for end < totalLines && !covered[end+1] {
+			end++
+		}
+		if end == line {
+			report("line %d is in no chunk", line)
+		} else {
+			report("lines %d-%d are in no chunk", line, end)
+		}
+		line = end
+	}
+
+	if len(problems) == 0 {
+		return nil
+	}
+	if len(problems) > maxValidationProblems {
+		problems = append(problems[:maxValidationProblems], fmt.Sprintf("and %d more", len(problems)-maxValidationProblems))
+	}
+	return fmt.Errorf("%w: %s", ErrInvalidChunks, strings.Join(problems, "; "))
+}
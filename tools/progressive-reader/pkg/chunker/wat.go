@@ -0,0 +1,117 @@
+package chunker
+
+import (
+	"regexp"
+	"strings"
+)
+
+// watField matches the start of a WebAssembly text module field, capturing
+// its keyword and its $name or, for an export, its exported name.
+var watField = regexp.MustCompile(`^\((\w+)(?:\s+(\$[^\s()]+|"[^"]*"))?`)
+
+// watImport matches the module and field names of an import.
+var watImport = regexp.MustCompile(`^\(import\s+"([^"]*)"\s+"([^"]*)"`)
+
+// watKinds maps module field keywords to chunk types.
+var watKinds = map[string]string{
+	"func":   "function",
+	"type":   "type",
+	"memory": "memory",
+	"global": "global",
+	"table":  "table",
+	"import": "import",
+	"export": "export",
+	"data":   "data",
+	"elem":   "elem",
+	"start":  "start",
+}
+
+// watRuns are the field types whose consecutive fields share a chunk.
+var watRuns = map[string]bool{"import": true, "export": true, "data": true, "elem": true}
+
+// chunkWAT splits a WebAssembly text file at its module fields: functions,
+// types, memories, globals and tables, with runs of imports, exports and
+// data or element segments sharing a chunk. There is no tree-sitter grammar
+// for WAT, so fields are found by tracking the S-expressions open at the
+// start of each line: a field starts a line directly inside `(module` or at
+// the top level of a file without one, and the `;;` or `(; ;)` comments
+// directly above it belong to it. Fields are named by their $identifier,
+// exports by their exported name and imports like "env.log".
+func (c *Chunker) chunkWAT() ([]Chunk, error) {
+	var decls []lineSection
+	var open []string // keyword of each S-expression open at the line start
+	inComment := false
+	lead := -1 // first line of the comments above the next field
+	for i, line := range c.sourceLines {
+		trimmed := strings.TrimSpace(line)
+		if !inComment && (len(open) == 0 || open[len(open)-1] == "module") {
+			switch {
+			case trimmed == "":
+				lead = -1
+			case strings.HasPrefix(trimmed, ";;") || strings.HasPrefix(trimmed, "(;"):
+				if lead < 0 {
+					lead = i
+				}
+			default:
+				m := watField.FindStringSubmatch(trimmed)
+				if m == nil || watKinds[m[1]] == "" {
+					lead = -1
+					break
+				}
+				kind, name := watKinds[m[1]], strings.Trim(m[2], `"`)
+				if im := watImport.FindStringSubmatch(trimmed); im != nil {
+					name = im[1] + "." + im[2]
+				}
+				start := i
+				if lead >= 0 {
+					start = lead
+				}
+				lead = -1
+				if prev := len(decls) - 1; watRuns[kind] && prev >= 0 && decls[prev].kind == kind && start == i {
+					break
+				}
+				decls = append(decls, lineSection{start: start, kind: kind, name: name})
+			}
+		}
+		open, inComment = watScan(line, open, inComment)
+	}
+	if len(decls) == 0 {
+		return c.chunkFallback()
+	}
+	return c.chunkSections(decls), nil
+}
+
+// watScan updates open, the keywords of the S-expressions open before line,
+// and inComment, whether a (; ;) block comment is, to their state after it.
+// Strings and comments are skipped.
+func watScan(line string, open []string, inComment bool) ([]string, bool) {
+	for j := 0; j < len(line); j++ {
+		switch {
+		case inComment:
+			if strings.HasPrefix(line[j:], ";)") {
+				inComment = false
+				j++
+			}
+		case strings.HasPrefix(line[j:], ";;"):
+			return open, false
+		case strings.HasPrefix(line[j:], "(;"):
+			inComment = true
+			j++
+		case line[j] == '"':
+			for j++; j < len(line) && line[j] != '"'; j++ {
+				if line[j] == '\\' {
+					j++
+				}
+			}
+		case line[j] == '(':
+			end := j + 1
+			for end < len(line) && (isWordRune(rune(line[end])) || strings.IndexByte("_.", line[end]) >= 0) {
+				end++
+			}
+			open = append(open, line[j+1:end])
+		case line[j] == ')' && len(open) > 0:
+			open = open[:len(open)-1]
+		}
+	}
+	return open, inComment
+}
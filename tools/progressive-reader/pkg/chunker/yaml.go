@@ -0,0 +1,41 @@
+package chunker
+
+import (
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+)
+
+// Top-level keys become chunks; an oversized key descends into its nested
+// keys so no chunk is cut mid-object.
+var yamlSpec = astSpec{
+	targets: map[string]bool{
+		"block_mapping_pair": true,
+		"flow_pair":          true,
+	},
+	typeName: extractYAMLNodeType,
+	nodeName: extractYAMLNodeName,
+	descend:  true,
+}
+
+func (c *Chunker) chunkYAML(tree *sitter.Tree) ([]Chunk, error) {
+	return c.chunkAST(tree, yamlSpec)
+}
+
+func extractYAMLNodeType(nodeType string) string {
+	switch nodeType {
+	case "block_mapping_pair", "flow_pair":
+		return "key"
+	default:
+		return "code"
+	}
+}
+
+// extractYAMLNodeName returns a pair's key with any surrounding quotes removed.
+func extractYAMLNodeName(node *sitter.Node, source string) string {
+	key := node.ChildByFieldName("key")
+	if key == nil || int(key.EndByte()) > len(source) {
+		return ""
+	}
+	return strings.Trim(source[key.StartByte():key.EndByte()], `"'`)
+}
@@ -0,0 +1,83 @@
+package chunker
+
+import (
+	"regexp"
+	"strings"
+)
+
+// zigDeclaration matches the first line of a top-level Zig declaration,
+// capturing its keyword and name. Visibility and linkage modifiers are
+// skipped; test names may be quoted strings or identifiers.
+var zigDeclaration = regexp.MustCompile(`^(?:pub\s+)?(?:export\s+|extern\s+(?:"\w+"\s+)?|inline\s+|noinline\s+)?(?:threadlocal\s+)?(fn|const|var|test|comptime)\b\s*(?:"([^"]*)"|(@?\w+))?`)
+
+// zigContainer matches the initializer of a const that declares a type,
+// capturing the container keyword.
+var zigContainer = regexp.MustCompile(`=\s*(?:extern\s+|packed\s+)?(struct|enum|union|opaque|error)\b`)
+
+// zigContainerKinds maps container keywords to chunk types.
+var zigContainerKinds = map[string]string{
+	"struct": "struct",
+	"enum":   "enum",
+	"union":  "union",
+	"opaque": "opaque",
+	"error":  "error_set",
+}
+
+// chunkZig splits a Zig file at its top-level declarations: functions,
+// containers (`const Foo = struct {...}`), test blocks, comptime blocks and
+// runs of consecutive const/var declarations such as imports, which share a
+// chunk. There is no tree-sitter grammar for Zig, so declarations are found
+// by line: they start unindented, and the `///` or `//` comments directly
+// above one belong to it. Test blocks are chunked on their own and typed
+// "test".
+func (c *Chunker) chunkZig() ([]Chunk, error) {
+	var decls []lineSection
+	lead := -1 // first line of the comments above the next declaration
+	for i, line := range c.sourceLines {
+		trimmed := strings.TrimSpace(line)
+		switch {
+		case trimmed == "" || line[0] == ' ' || line[0] == '\t' || strings.HasPrefix(trimmed, "//!"):
+			lead = -1
+		case strings.HasPrefix(trimmed, "//"):
+			if lead < 0 {
+				lead = i
+			}
+		default:
+			if m := zigDeclaration.FindStringSubmatch(line); m != nil {
+				kind, name := zigDeclarationKind(m[1], line), m[2]+m[3]
+				start := i
+				if lead >= 0 {
+					start = lead
+				}
+				// Consecutive plain constants and variables stay together
+				if prev := len(decls) - 1; kind == "variable" && prev >= 0 && decls[prev].kind == "variable" && lead < 0 {
+					lead = -1
+					continue
+				}
+				decls = append(decls, lineSection{start: start, kind: kind, name: name})
+			}
+			lead = -1
+		}
+	}
+	if len(decls) == 0 {
+		return c.chunkFallback()
+	}
+	return c.chunkSections(decls), nil
+}
+
+// zigDeclarationKind maps a declaration keyword to a chunk type. A const or
+// var initialized with a container is typed after the container.
+func zigDeclarationKind(keyword, line string) string {
+	switch keyword {
+	case "fn":
+		return "function"
+	case "test":
+		return "test"
+	case "comptime":
+		return "comptime"
+	}
+	if m := zigContainer.FindStringSubmatch(line); m != nil {
+		return zigContainerKinds[m[1]]
+	}
+	return "variable"
+}
@@ -3,6 +3,7 @@ package formatter
 import (
 	"fmt"
 	"strings"
+	"unicode/utf8"
 
 	"github.com/arpitnath/super-claude-kit/tools/progressive-reader/pkg/chunker"
 )
@@ -27,10 +28,22 @@ func FormatChunk(chunk chunker.Chunk, filePath string, tokenPath string) string
 		output.WriteString(fmt.Sprintf("│ Name: %-47s│\n", truncate(chunk.Name, 47)))
 	}
 
+	if chunk.Parent != "" {
+		output.WriteString(fmt.Sprintf("│ Parent: %-45s│\n", truncate(chunk.Parent, 45)))
+	}
+
+	if chunk.Breadcrumb != "" && chunk.Breadcrumb != chunk.Name {
+		output.WriteString(fmt.Sprintf("│ Section: %-44s│\n", truncate(chunk.Breadcrumb, 44)))
+	}
+
 	if chunk.Context != "" {
 		output.WriteString(fmt.Sprintf("│ Context: %-44s│\n", truncate(chunk.Context, 44)))
 	}
 
+	if chunk.PrevName != "" {
+		output.WriteString(fmt.Sprintf("│ Previous: %-43s│\n", truncate(chunk.PrevName, 43)))
+	}
+
 	output.WriteString("└─────────────────────────────────────────────────────┘\n")
 	output.WriteString("\n")
 
@@ -46,6 +59,9 @@ func FormatChunk(chunk chunker.Chunk, filePath string, tokenPath string) string
 	if chunk.HasMore {
 		output.WriteString("┌─────────────────────────────────────────────────────┐\n")
 		output.WriteString("│ More content available                              │\n")
+		if chunk.NextName != "" {
+			output.WriteString(fmt.Sprintf("│ Next: %-46s│\n", truncate(chunk.NextName, 46)))
+		}
 		if tokenPath != "" {
 			pathDisplay := truncate(tokenPath, 38)
 			output.WriteString(fmt.Sprintf("│ Continuation token saved to: %-23s│\n", pathDisplay))
@@ -65,7 +81,8 @@ func FormatChunkList(chunks []chunker.Chunk, filePath string) string {
 	var output strings.Builder
 
 	output.WriteString(fmt.Sprintf("File: %s\n", filePath))
-	output.WriteString(fmt.Sprintf("Total chunks: %d\n\n", len(chunks)))
+	output.WriteString(fmt.Sprintf("Total chunks: %d\n", len(chunks)))
+	output.WriteString(fmt.Sprintf("Total tokens: ~%d\n\n", chunker.TotalTokens(chunks)))
 
 	for i, chunk := range chunks {
 		typeInfo := chunk.Type
@@ -89,9 +106,11 @@ func FormatChunkList(chunks []chunker.Chunk, filePath string) string {
 	return output.String()
 }
 
+// truncate cuts s to maxLen characters, counting and cutting by rune so a
+// multibyte character is never split.
 func truncate(s string, maxLen int) string {
-	if len(s) <= maxLen {
+	if utf8.RuneCountInString(s) <= maxLen {
 		return s
 	}
-	return s[:maxLen-3] + "..."
+	return string([]rune(s)[:maxLen-3]) + "..."
 }
@@ -1,32 +1,93 @@
 package parser
 
 import (
+	"bytes"
+	"errors"
 	"fmt"
 	"path/filepath"
 	"strings"
 
 	sitter "github.com/smacker/go-tree-sitter"
+	"github.com/smacker/go-tree-sitter/bash"
+	"github.com/smacker/go-tree-sitter/csharp"
+	"github.com/smacker/go-tree-sitter/css"
+	"github.com/smacker/go-tree-sitter/elixir"
 	"github.com/smacker/go-tree-sitter/golang"
+	"github.com/smacker/go-tree-sitter/hcl"
+	"github.com/smacker/go-tree-sitter/html"
 	"github.com/smacker/go-tree-sitter/javascript"
+	"github.com/smacker/go-tree-sitter/kotlin"
+	"github.com/smacker/go-tree-sitter/lua"
+	"github.com/smacker/go-tree-sitter/php"
+	"github.com/smacker/go-tree-sitter/protobuf"
 	"github.com/smacker/go-tree-sitter/python"
+	"github.com/smacker/go-tree-sitter/ruby"
+	"github.com/smacker/go-tree-sitter/scala"
+	"github.com/smacker/go-tree-sitter/sql"
+	"github.com/smacker/go-tree-sitter/swift"
+	"github.com/smacker/go-tree-sitter/typescript/tsx"
 	"github.com/smacker/go-tree-sitter/typescript/typescript"
+	"github.com/smacker/go-tree-sitter/yaml"
 )
 
+var (
+	// ErrUnsupportedLanguage is returned when no grammar is available for a
+	// file's language.
+	ErrUnsupportedLanguage = errors.New("unsupported language")
+	// ErrParseFailed is returned when tree-sitter produces no tree at all.
+	ErrParseFailed = errors.New("parse failed")
+)
+
+// lineBased lists the languages chunked without tree-sitter.
+var lineBased = map[string]bool{
+	"markdown":   true,
+	"text":       true,
+	"vue":        true,
+	"svelte":     true,
+	"dockerfile": true,
+	"graphql":    true,
+	"zig":        true,
+	"dart":       true,
+	"haskell":    true,
+	"objc":       true,
+	"perl":       true,
+	"r":          true,
+	"julia":      true,
+	"wat":        true,
+	"ini":        true,
+	"env":        true,
+	"properties": true,
+}
+
 type Parser struct {
 	parser   *sitter.Parser
 	language *sitter.Language
 	langName string
+	grammar  string
 }
 
 func NewParser(filePath string) (*Parser, error) {
-	lang := DetectLanguage(filePath)
+	return newParser(DetectLanguage(filePath), DetectGrammar(filePath))
+}
+
+// NewParserForLanguage returns a parser for lang, a language name as
+// DetectLanguage returns it, for content that has no file path. Each
+// language is parsed with its own grammar, so JSX needs the language to be
+// given as part of a path: see DetectGrammar.
+func NewParserForLanguage(lang string) (*Parser, error) {
+	return newParser(lang, lang)
+}
 
+// newParser returns a parser for lang that parses with grammar, which is
+// lang itself or "tsx".
+func newParser(lang, grammar string) (*Parser, error) {
 	// Non-AST languages: return nil parser, chunker handles them directly
-	if lang == "markdown" || lang == "text" {
+	if lineBased[lang] {
 		return &Parser{
 			parser:   nil,
 			language: nil,
 			langName: lang,
+			grammar:  lang,
 		}, nil
 	}
 
@@ -40,8 +101,46 @@ func NewParser(filePath string) (*Parser, error) {
 		tsLang = python.GetLanguage()
 	case "go":
 		tsLang = golang.GetLanguage()
+	case "bash":
+		tsLang = bash.GetLanguage()
+	case "json", "yaml":
+		// JSON is a subset of YAML; the YAML grammar parses both.
+		tsLang = yaml.GetLanguage()
+	case "ruby":
+		tsLang = ruby.GetLanguage()
+	case "php":
+		tsLang = php.GetLanguage()
+	case "kotlin":
+		tsLang = kotlin.GetLanguage()
+	case "scala":
+		tsLang = scala.GetLanguage()
+	case "swift":
+		tsLang = swift.GetLanguage()
+	case "html":
+		tsLang = html.GetLanguage()
+	case "css", "scss", "less":
+		// SCSS and LESS are parsed with the CSS grammar, which accepts
+		// nested rules.
+		tsLang = css.GetLanguage()
+	case "sql":
+		tsLang = sql.GetLanguage()
+	case "csharp":
+		tsLang = csharp.GetLanguage()
+	case "elixir":
+		tsLang = elixir.GetLanguage()
+	case "lua":
+		tsLang = lua.GetLanguage()
+	case "proto":
+		tsLang = protobuf.GetLanguage()
+	case "hcl":
+		tsLang = hcl.GetLanguage()
 	default:
-		return nil, fmt.Errorf("unsupported language: %s", lang)
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedLanguage, lang)
+	}
+	// The TypeScript grammar rejects JSX, which .tsx and .jsx files are
+	// full of; the tsx grammar parses both JSX and type annotations
+	if grammar == "tsx" {
+		tsLang = tsx.GetLanguage()
 	}
 
 	p := sitter.NewParser()
@@ -51,22 +150,160 @@ func NewParser(filePath string) (*Parser, error) {
 		parser:   p,
 		language: tsLang,
 		langName: lang,
+		grammar:  grammar,
 	}, nil
 }
 
+// NewParserFor is NewParser for a file whose content is at hand, which
+// settles extensions several languages share: a .h header using
+// Objective-C directives is "objc" rather than plain text.
+func NewParserFor(filePath string, content []byte) (*Parser, error) {
+	if isObjCHeader(filePath, content) {
+		return &Parser{langName: "objc", grammar: "objc"}, nil
+	}
+	return NewParser(filePath)
+}
+
+// Pool caches one Parser per language and grammar so that chunking many files reuses the
+// tree-sitter setup instead of repeating it per file. A Pool and the parsers it
+// hands out are not safe for concurrent use; give each goroutine its own Pool.
+type Pool struct {
+	parsers map[string]*Parser
+}
+
+func NewPool() *Pool {
+	return &Pool{parsers: make(map[string]*Parser)}
+}
+
+// ForFile returns the pooled parser for filePath's language and grammar,
+// creating it on first use. .jsx and .tsx files share a grammar but not a
+// language, so each gets a parser of its own.
+func (pl *Pool) ForFile(filePath string) (*Parser, error) {
+	key := DetectLanguage(filePath) + "/" + DetectGrammar(filePath)
+	if p, ok := pl.parsers[key]; ok {
+		return p, nil
+	}
+
+	p, err := NewParser(filePath)
+	if err != nil {
+		return nil, err
+	}
+	pl.parsers[key] = p
+	return p, nil
+}
+
+// ForContent is ForFile for a file whose content is at hand; see
+// NewParserFor.
+func (pl *Pool) ForContent(filePath string, content []byte) (*Parser, error) {
+	key := DetectLanguageFor(filePath, content) + "/" + DetectGrammarFor(filePath, content)
+	if p, ok := pl.parsers[key]; ok {
+		return p, nil
+	}
+
+	p, err := NewParserFor(filePath, content)
+	if err != nil {
+		return nil, err
+	}
+	pl.parsers[key] = p
+	return p, nil
+}
+
+// DetectGrammar returns the tree-sitter grammar filePath is parsed with. It
+// is the file's language except for .tsx and .jsx files, which are parsed
+// with the JSX-enabled tsx grammar.
+func DetectGrammar(filePath string) string {
+	switch strings.ToLower(filepath.Ext(filePath)) {
+	case ".tsx", ".jsx":
+		return "tsx"
+	}
+	return DetectLanguage(filePath)
+}
+
+// DetectLanguageFor is DetectLanguage for a file whose content is at hand;
+// see NewParserFor.
+func DetectLanguageFor(filePath string, content []byte) string {
+	if isObjCHeader(filePath, content) {
+		return "objc"
+	}
+	return DetectLanguage(filePath)
+}
+
+// DetectGrammarFor is DetectGrammar for a file whose content is at hand;
+// see NewParserFor.
+func DetectGrammarFor(filePath string, content []byte) string {
+	if isObjCHeader(filePath, content) {
+		return "objc"
+	}
+	return DetectGrammar(filePath)
+}
+
+// objcDirectives start lines found in Objective-C headers but not in C or
+// C++ ones.
+var objcDirectives = [][]byte{[]byte("@interface"), []byte("@protocol"), []byte("@class"), []byte("@import"), []byte("#import")}
+
+// isObjCHeader reports whether filePath is a .h header whose content uses
+// Objective-C directives.
+func isObjCHeader(filePath string, content []byte) bool {
+	if strings.ToLower(filepath.Ext(filePath)) != ".h" {
+		return false
+	}
+	for _, line := range bytes.Split(content, []byte("\n")) {
+		line = bytes.TrimSpace(line)
+		for _, d := range objcDirectives {
+			if bytes.HasPrefix(line, d) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func (p *Parser) Parse(sourceCode []byte) (*sitter.Tree, error) {
 	tree := p.parser.Parse(nil, sourceCode)
 	if tree == nil {
-		return nil, fmt.Errorf("failed to parse source code")
+		return nil, ErrParseFailed
 	}
 	return tree, nil
 }
 
+// Reparse parses sourceCode incrementally, reusing the unchanged parts of
+// oldTree. oldTree must already have been edited with Tree.Edit to describe
+// how sourceCode differs from the text it was parsed from.
+func (p *Parser) Reparse(oldTree *sitter.Tree, sourceCode []byte) (*sitter.Tree, error) {
+	tree := p.parser.Parse(oldTree, sourceCode)
+	if tree == nil {
+		return nil, ErrParseFailed
+	}
+	return tree, nil
+}
+
+// HasGrammar reports whether the parser parses with tree-sitter, as opposed
+// to a language the chunker splits by lines.
+func (p *Parser) HasGrammar() bool {
+	return p.parser != nil
+}
+
 func (p *Parser) GetLanguage() string {
 	return p.langName
 }
 
+// GetGrammar returns the grammar the parser parses with, as DetectGrammar
+// names it.
+func (p *Parser) GetGrammar() string {
+	return p.grammar
+}
+
 func DetectLanguage(filePath string) string {
+	// Dockerfiles are named rather than extended: Dockerfile, Dockerfile.dev
+	base := strings.ToLower(filepath.Base(filePath))
+	if base == "dockerfile" || strings.HasPrefix(base, "dockerfile.") {
+		return "dockerfile"
+	}
+	// Likewise .env files: .env, .env.local, .env.production
+	if base == ".env" || strings.HasPrefix(base, ".env.") {
+		return "env"
+	}
+
 	ext := strings.ToLower(filepath.Ext(filePath))
 	switch ext {
 	case ".ts", ".tsx":
@@ -77,6 +314,72 @@ func DetectLanguage(filePath string) string {
 		return "python"
 	case ".go":
 		return "go"
+	case ".sh", ".bash":
+		return "bash"
+	case ".json":
+		return "json"
+	case ".yaml", ".yml":
+		return "yaml"
+	case ".rb":
+		return "ruby"
+	case ".php":
+		return "php"
+	case ".kt", ".kts":
+		return "kotlin"
+	case ".scala", ".sc":
+		return "scala"
+	case ".swift":
+		return "swift"
+	case ".html", ".htm":
+		return "html"
+	case ".css":
+		return "css"
+	case ".scss":
+		return "scss"
+	case ".less":
+		return "less"
+	case ".sql":
+		return "sql"
+	case ".cs":
+		return "csharp"
+	case ".vue":
+		return "vue"
+	case ".svelte":
+		return "svelte"
+	case ".ex", ".exs":
+		return "elixir"
+	case ".lua":
+		return "lua"
+	case ".tf", ".hcl":
+		return "hcl"
+	case ".proto":
+		return "proto"
+	case ".graphql", ".gql":
+		return "graphql"
+	case ".dockerfile":
+		return "dockerfile"
+	case ".zig":
+		return "zig"
+	case ".dart":
+		return "dart"
+	case ".hs":
+		return "haskell"
+	case ".m", ".mm":
+		return "objc"
+	case ".pl", ".pm":
+		return "perl"
+	case ".r":
+		return "r"
+	case ".jl":
+		return "julia"
+	case ".wat", ".wast":
+		return "wat"
+	case ".ini", ".cfg":
+		return "ini"
+	case ".env":
+		return "env"
+	case ".properties":
+		return "properties"
 	case ".md", ".markdown", ".mdx":
 		return "markdown"
 	default:
@@ -0,0 +1,33 @@
+package parser
+
+import "testing"
+
+func TestPoolKeepsLanguagesSharingAGrammarApart(t *testing.T) {
+	pool := NewPool()
+	tests := []struct {
+		path, language, grammar string
+	}{
+		{"a.jsx", "javascript", "tsx"},
+		{"b.tsx", "typescript", "tsx"},
+		{"c.ts", "typescript", "typescript"},
+		{"d.js", "javascript", "javascript"},
+	}
+	for _, tt := range tests {
+		p, err := pool.ForFile(tt.path)
+		if err != nil {
+			t.Fatalf("ForFile(%q): %v", tt.path, err)
+		}
+		if p.GetLanguage() != tt.language || p.GetGrammar() != tt.grammar {
+			t.Errorf("ForFile(%q) = %s parser with %s grammar, want %s with %s", tt.path, p.GetLanguage(), p.GetGrammar(), tt.language, tt.grammar)
+		}
+	}
+	for _, tt := range tests {
+		p, err := pool.ForContent(tt.path, []byte("let x = 1\n"))
+		if err != nil {
+			t.Fatalf("ForContent(%q): %v", tt.path, err)
+		}
+		if p.GetLanguage() != tt.language || p.GetGrammar() != tt.grammar {
+			t.Errorf("ForContent(%q) = %s parser with %s grammar, want %s with %s", tt.path, p.GetLanguage(), p.GetGrammar(), tt.language, tt.grammar)
+		}
+	}
+}
@@ -17,6 +17,12 @@ type ContinuationToken struct {
 	Checksum     string
 	CurrentChunk int
 	HasMore      bool
+
+	// Chunking flags of the read that saved the token, so the next read
+	// chunks the file the same way; MaxTokens 0 means the default
+	MaxTokens    int
+	MarkUnparsed bool
+	OnePerSymbol bool
 }
 
 func NewContinuationToken(file string, offset int, language string, totalChunks int, content []byte) *ContinuationToken {
@@ -53,6 +59,11 @@ func (t *ContinuationToken) SaveToFile(path string) error {
 	} else {
 		fmt.Fprintf(writer, "CONTINUE:hasMore=false\n")
 	}
+	if t.MaxTokens > 0 {
+		fmt.Fprintf(writer, "CONTINUE:maxTokens=%d\n", t.MaxTokens)
+	}
+	fmt.Fprintf(writer, "CONTINUE:markUnparsed=%t\n", t.MarkUnparsed)
+	fmt.Fprintf(writer, "CONTINUE:onePerSymbol=%t\n", t.OnePerSymbol)
 	fmt.Fprintf(writer, "---\n")
 
 	return writer.Flush()
@@ -114,6 +125,16 @@ func LoadFromFile(path string) (*ContinuationToken, error) {
 			token.CurrentChunk = current
 		case "hasMore":
 			token.HasMore = value == "true"
+		case "maxTokens":
+			maxTokens, err := strconv.Atoi(value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid maxTokens value: %w", err)
+			}
+			token.MaxTokens = maxTokens
+		case "markUnparsed":
+			token.MarkUnparsed = value == "true"
+		case "onePerSymbol":
+			token.OnePerSymbol = value == "true"
 		}
 	}
 
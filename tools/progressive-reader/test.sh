@@ -127,7 +127,583 @@ test_case "Read chunk 0 creates continuation token" "$BINARY --path testdata/typ
 test_case "Continuation token has correct format" "cat /tmp/continue.toon" "CONTINUE:file="
 test_case "Continue to next chunk" "$BINARY --continue-file /tmp/continue.toon" "Chunk [2-9]/"
 rm -f /tmp/continue.toon
+test_case "Continuation token records the chunking flags" "$BINARY --path testdata/python/sample.py --max-tokens 100 --one-per-symbol > /dev/null && cat /tmp/continue.toon" "CONTINUE:onePerSymbol=true"
+test_case "Continued read chunks with the saved flags" "$BINARY --continue-file /tmp/continue.toon" "Chunk 2/13"
+rm -f /tmp/continue.toon
+echo ""
+
+echo "12. Bash File Tests"
+echo "----------------------------------------"
+test_case "Read Bash file" "$BINARY --path testdata/bash/sample.sh" "create_backup"
+test_case "List Bash chunks" "$BINARY --path testdata/bash/sample.sh --list" "Total chunks:"
+test_case "Bash shows function chunk" "$BINARY --path testdata/bash/sample.sh --list --max-tokens 60" "function: prune_backups"
+echo ""
+
+echo "13. JSON and YAML File Tests"
+echo "----------------------------------------"
+test_case "List JSON chunks" "$BINARY --path testdata/json/sample.json --list" "Total chunks:"
+test_case "JSON chunk named by top-level key" "$BINARY --path testdata/json/sample.json --list --max-tokens 60" "key: devDependencies"
+test_case "List YAML chunks" "$BINARY --path testdata/yaml/sample.yaml --list" "Total chunks:"
+test_case "YAML chunk named by top-level key" "$BINARY --path testdata/yaml/sample.yaml --list --max-tokens 60" "key: deploy"
+echo ""
+
+echo "14. Ruby File Tests"
+echo "----------------------------------------"
+test_case "Read Ruby file" "$BINARY --path testdata/ruby/sample.rb" "UserRepository"
+test_case "List Ruby chunks" "$BINARY --path testdata/ruby/sample.rb --list" "Total chunks:"
+test_case "Ruby chunk named by class constant" "$BINARY --path testdata/ruby/sample.rb --list --max-tokens 100" "class: User"
+echo ""
+
+echo "15. PHP File Tests"
+echo "----------------------------------------"
+test_case "Read PHP file" "$BINARY --path testdata/php/sample.php" "AuthService"
+test_case "List PHP chunks" "$BINARY --path testdata/php/sample.php --list" "Total chunks:"
+test_case "PHP inline HTML is its own chunk" "$BINARY --path testdata/php/sample.php --list --max-tokens 60" "): html"
+test_case "PHP shows trait chunk" "$BINARY --path testdata/php/sample.php --list --max-tokens 60" "trait: LogsActivity"
+echo ""
+
+echo "16. Kotlin File Tests"
+echo "----------------------------------------"
+test_case "Read Kotlin file" "$BINARY --path testdata/kotlin/sample.kt" "AuthService"
+test_case "List Kotlin chunks" "$BINARY --path testdata/kotlin/sample.kt --list" "Total chunks:"
+test_case "Kotlin shows object chunk" "$BINARY --path testdata/kotlin/sample.kt --list --max-tokens 80" "object: PasswordHasher"
+echo ""
+
+echo "17. Swift File Tests"
+echo "----------------------------------------"
+test_case "Read Swift file" "$BINARY --path testdata/swift/sample.swift" "AuthService"
+test_case "List Swift chunks" "$BINARY --path testdata/swift/sample.swift --list" "Total chunks:"
+test_case "Swift labels structs" "$BINARY --path testdata/swift/sample.swift --list --max-tokens 80" "struct: User"
+test_case "Swift labels extensions" "$BINARY --path testdata/swift/sample.swift --list --max-tokens 80" "extension: AuthService"
+echo ""
+
+echo "18. HTML File Tests"
+echo "----------------------------------------"
+test_case "Read HTML file" "$BINARY --path testdata/html/sample.html" "Account Dashboard"
+test_case "List HTML chunks" "$BINARY --path testdata/html/sample.html --list" "Total chunks:"
+test_case "HTML names elements by tag and class" "$BINARY --path testdata/html/sample.html --list --max-tokens 120" "element: section.card"
+echo ""
+
+echo "19. CSS/SCSS File Tests"
+echo "----------------------------------------"
+test_case "List SCSS chunks" "$BINARY --path testdata/css/sample.scss --list" "Total chunks:"
+test_case "SCSS rule named by selector" "$BINARY --path testdata/css/sample.scss --list --max-tokens 60" "rule: .card"
+test_case "SCSS media block chunk" "$BINARY --path testdata/css/sample.scss --list --max-tokens 60" "media: @media (max-width: 600px)"
+echo ""
+
+echo "20. SQL File Tests"
+echo "----------------------------------------"
+test_case "List SQL chunks" "$BINARY --path testdata/sql/sample.sql --list" "Total chunks:"
+test_case "SQL statement named by table" "$BINARY --path testdata/sql/sample.sql --list --max-tokens 60" "create_table: accounts"
+test_case "SQL keeps ALTER as its own statement" "$BINARY --path testdata/sql/sample.sql --list --max-tokens 40" "alter_table: accounts"
+echo ""
+
+echo "21. Binary File Tests"
+echo "----------------------------------------"
+test_case "Binary file is rejected" "$BINARY --path testdata/binary/sample.png 2>&1" "binary file"
+echo ""
+
+echo "22. Empty File Tests"
+echo "----------------------------------------"
+test_case "Empty file has no chunks" "$BINARY --path testdata/empty/empty.go --list" "Total chunks: 0"
+test_case "Single newline is one chunk" "$BINARY --path testdata/empty/newline.py --list" "Total chunks: 1"
+test_case "Whitespace-only file is one chunk" "$BINARY --path testdata/empty/whitespace.ts --list" "Total chunks: 1"
+test_case "Reading an empty file reports empty input" "$BINARY --path testdata/empty/empty.go 2>&1" "no chunks generated: empty input"
+echo ""
+
+echo "23. Minified File Tests"
+echo "----------------------------------------"
+test_case "100KB single-line JS is split" "$BINARY --path testdata/javascript/minified.js --list" "Total chunks: 13"
+test_case "Split pieces stay on line 1" "$BINARY --path testdata/javascript/minified.js --list" "Chunk 13/13 (lines 1-1)"
+test_case "Minified CSS is split by bytes, not per rule" "$BINARY --path testdata/css/minified.css --list" "Total chunks: 4"
+test_case "Minified CSS pieces share line 1" "$BINARY --path testdata/css/minified.css --list" "Chunk 4/4 (lines 1-1)"
+echo ""
+
+echo "24. C# File Tests"
+echo "----------------------------------------"
+test_case "List C# chunks" "$BINARY --path testdata/csharp/sample.cs --list" "Total chunks:"
+test_case "Oversized namespace splits into classes" "$BINARY --path testdata/csharp/sample.cs --list --max-tokens 300" "class: InMemoryItemRepository"
+test_case "Oversized class splits into methods" "$BINARY --path testdata/csharp/sample.cs --list --max-tokens 80" "method: LevelFor"
+echo ""
+
+echo "25. Parent Tests"
+echo "----------------------------------------"
+test_case "Go method parent is its receiver type" "$BINARY --path testdata/golang/sample.go --chunk 1 --max-tokens 100" "Parent: UserRepository"
+test_case "C# method parent is its class" "$BINARY --path testdata/csharp/sample.cs --chunk 3 --max-tokens 80" "Parent: InMemoryItemRepository"
+test_case "Python method parent is its class" "$BINARY --path testdata/python/sample.py --chunk 1 --max-tokens 80" "Parent: UserRepository"
+echo ""
+
+echo "26. Token Budget Tests"
+echo "----------------------------------------"
+test_case "List reports total tokens" "$BINARY --path testdata/golang/sample.go --list" "Total tokens: ~748"
+test_case "Empty file costs no tokens" "$BINARY --path testdata/empty/empty.go --list" "Total tokens: ~0"
+echo ""
+
+echo "27. Markdown Splitting Tests"
+echo "----------------------------------------"
+test_case "Oversized section runs on to the closing fence" "$BINARY --path testdata/markdown/fenced.md --list --max-tokens 100" "lines 1-42): section: Deployment Guide"
+test_case "Next piece starts after the fence" "$BINARY --path testdata/markdown/fenced.md --list --max-tokens 100" "lines 43-56): section: Deployment Guide (cont.)"
+test_case "Oversized section keeps a list item with its continuation lines" "$BINARY --path testdata/markdown/lists.md --list --max-tokens 100" "lines 1-23): section: Release Checklist"
+test_case "Next piece starts at the next list item" "$BINARY --path testdata/markdown/lists.md --list --max-tokens 100" "^  12. Promote the staged artifacts"
+test_case "Blank line after frontmatter goes with it" "$BINARY --path testdata/markdown/frontmatter.md --list" "lines 1-5): frontmatter: YAML Frontmatter"
+test_case "Frontmatter file validates" "$BINARY --path testdata/markdown/frontmatter.md --validate" "valid: 4 chunks cover 17 lines"
+echo ""
+
+echo "28. Prose Text Tests"
+echo "----------------------------------------"
+test_case "Short paragraphs are packed together" "$BINARY --path testdata/text/sample.txt --list --max-tokens 100" "lines 33-37): text"
+test_case "Long paragraph splits between sentences" "$BINARY --path testdata/text/sample.txt --list --max-tokens 100" "^  Sample 3 showed"
+test_case "Prose chunks validate at 60 tokens" "$BINARY --path testdata/text/sample.txt --validate --max-tokens 60" "valid: 14 chunks cover 37 lines"
+test_case "Prose chunks validate at the default budget" "$BINARY --path testdata/text/sample.txt --validate" "valid: 1 chunks cover 37 lines"
+echo ""
+
+echo "29. Vue File Tests"
+echo "----------------------------------------"
+test_case "Vue template is its own chunk" "$BINARY --path testdata/vue/sample.vue --list" "lines 1-10): template: template"
+test_case "Vue script is chunked as TypeScript" "$BINARY --path testdata/vue/sample.vue --list --max-tokens 60" "interface: script > State"
+test_case "Vue style lines map back to the file" "$BINARY --path testdata/vue/sample.vue --list" "lines 45-59): code: style"
+test_case "Vue chunks cover the file" "$BINARY --path testdata/vue/sample.vue --validate" "valid: 3 chunks cover 59 lines"
+test_case "Vue chunks cover the file when split" "$BINARY --path testdata/vue/sample.vue --validate --max-tokens 40" "valid: 8 chunks cover 59 lines"
+echo ""
+
+echo "30. Svelte File Tests"
+echo "----------------------------------------"
+test_case "Svelte markup is chunked between blocks" "$BINARY --path testdata/svelte/sample.svelte --list" "lines 28-44): markup: markup"
+test_case "Svelte script is chunked as TypeScript" "$BINARY --path testdata/svelte/sample.svelte --list --max-tokens 60" "function: script > toggle"
+test_case "Svelte style is its own chunk" "$BINARY --path testdata/svelte/sample.svelte --list" "lines 45-55): code: style"
+test_case "Svelte chunks cover the file" "$BINARY --path testdata/svelte/sample.svelte --validate" "valid: 4 chunks cover 55 lines"
+test_case "Svelte chunks cover the file when split" "$BINARY --path testdata/svelte/sample.svelte --validate --max-tokens 40" "valid: 9 chunks cover 55 lines"
+echo ""
+
+echo "31. Nesting Depth Tests"
+echo "----------------------------------------"
+test_case "Top-level function is not indented" "$BINARY --path testdata/python/sample.py --list --max-tokens 80" "^Chunk 15/15 (lines 87-105): function: main"
+test_case "Method inside class is indented once" "$BINARY --path testdata/python/sample.py --list --max-tokens 80" "^  Chunk 3/15 (lines 16-22): function: find_by_id"
+test_case "Method inside class inside namespace is indented twice" "$BINARY --path testdata/csharp/sample.cs --list --max-tokens 80" "^    Chunk 6/9 (lines 47-55): method: Find"
+echo ""
+
+echo "32. Syntax Error Tests"
+echo "----------------------------------------"
+test_case "Declarations before a syntax error are chunked" "$BINARY --path testdata/python/broken.py --list --max-tokens 30" "Chunk 4/4 (lines 12-24): function: get"
+echo ""
+
+echo "33. Dockerfile Tests"
+echo "----------------------------------------"
+test_case "Global ARGs form a preamble" "$BINARY --path testdata/dockerfile/Dockerfile --list --max-tokens 100" "Chunk 1/5 (lines 1-5): preamble"
+test_case "Stage is named by its alias" "$BINARY --path testdata/dockerfile/Dockerfile --list --max-tokens 100" "Chunk 3/5 (lines 14-22): stage: builder"
+test_case "Stage without alias is named by its image" "$BINARY --path testdata/dockerfile/Dockerfile --list --max-tokens 100" "stage: gcr.io/distroless/static-debian12"
+echo ""
+
+echo "34. Elixir File Tests"
+echo "----------------------------------------"
+test_case "Elixir def is chunked as a function" "$BINARY --path testdata/elixir/sample.ex --list --max-tokens 60" "function: get_user"
+test_case "Elixir guard does not affect the name" "$BINARY --path testdata/elixir/sample.ex --list --max-tokens 60" "function: authenticate"
+test_case "Elixir defp and defmacro are typed" "$BINARY --path testdata/elixir/sample.ex --list --max-tokens 60" "private_function: verify_password"
+test_case "Elixir nested defmodule is named by its alias" "$BINARY --path testdata/elixir/sample.ex --list --max-tokens 60" "module: User"
+echo ""
+
+echo "35. Lua File Tests"
+echo "----------------------------------------"
+test_case "Lua local function" "$BINARY --path testdata/lua/sample.lua --list --max-tokens 20" "Chunk 2/14 (lines 2-6): local_function: clamp"
+test_case "Lua dotted function name" "$BINARY --path testdata/lua/sample.lua --list --max-tokens 20" "function: M.new"
+test_case "Lua method with colon" "$BINARY --path testdata/lua/sample.lua --list --max-tokens 20" "method: M:add"
+test_case "Lua function assigned to a table field" "$BINARY --path testdata/lua/sample.lua --list --max-tokens 20" "function: M.on_change"
+echo ""
+
+echo "36. GraphQL File Tests"
+echo "----------------------------------------"
+test_case "GraphQL type takes its description" "$BINARY --path testdata/graphql/schema.graphql --list --max-tokens 100" "Chunk 3/11 (lines 5-16): type: Book"
+test_case "GraphQL enum" "$BINARY --path testdata/graphql/schema.graphql --list --max-tokens 100" "enum: Genre"
+test_case "GraphQL root operation type" "$BINARY --path testdata/graphql/schema.graphql --list --max-tokens 100" "type: Mutation"
+test_case "GraphQL union on one line" "$BINARY --path testdata/graphql/schema.graphql --list --max-tokens 100" "Chunk 7/11 (lines 34-35): union: SearchResult"
+echo ""
+
+echo "37. Protobuf File Tests"
+echo "----------------------------------------"
+test_case "Protobuf enum" "$BINARY --path testdata/proto/sample.proto --list --max-tokens 40" "enum: Genre"
+test_case "Protobuf message" "$BINARY --path testdata/proto/sample.proto --list --max-tokens 20" "message: ListBooksRequest"
+test_case "Protobuf service" "$BINARY --path testdata/proto/sample.proto --list --max-tokens 40" "service: BookService"
+echo ""
+
+echo "38. Terraform/HCL File Tests"
+echo "----------------------------------------"
+test_case "HCL resource named by type and labels" "$BINARY --path testdata/hcl/main.tf --list --max-tokens 30" "resource: resource.aws_instance.web"
+test_case "HCL data source with a nested block" "$BINARY --path testdata/hcl/main.tf --list --max-tokens 30" "Chunk 3/8 (lines 18-24): data: data.aws_ami.ubuntu"
+test_case "HCL module" "$BINARY --path testdata/hcl/main.tf --list --max-tokens 30" "module: module.vpc"
+echo ""
+
+echo "39. Comment Context Tests"
+echo "----------------------------------------"
+test_case "Python docstring is the context" "$BINARY --path testdata/python/sample.py --list --max-tokens 80" "^    Find a user by their ID"
+test_case "Lua -- comment is the context" "$BINARY --path testdata/lua/sample.lua --list --max-tokens 1000" "^  Inventory module for the game"
+test_case "Dockerfile # comment is the context" "$BINARY --path testdata/dockerfile/Dockerfile --list --max-tokens 100" "^  Build the web assets"
+test_case "GraphQL block description is the context" "$BINARY --path testdata/graphql/schema.graphql --list --max-tokens 100" "^  A book in the catalogue."
+echo ""
+
+echo "40. Boilerplate Context Tests"
+echo "----------------------------------------"
+test_case "Bash shell options are skipped" "$BINARY --path testdata/bash/sample.sh --list --max-tokens 80" "^  BACKUP_DIR="
+test_case "SCSS @import is skipped" "$BINARY --path testdata/css/sample.scss --list --max-tokens 80" "^  @mixin card"
+test_case "Dockerfile parser directive is skipped" "$BINARY --path testdata/dockerfile/Dockerfile --list --max-tokens 100" "^  ARG GO_VERSION"
+echo ""
+
+echo "41. Zig File Tests"
+echo "----------------------------------------"
+test_case "Zig imports share a chunk" "$BINARY --path testdata/zig/sample.zig --list --max-tokens 200" "Chunk 2/9 (lines 3-8): variable: std"
+test_case "Zig struct takes its doc comment" "$BINARY --path testdata/zig/sample.zig --list --max-tokens 200" "Chunk 4/9 (lines 15-39): struct: RingBuffer"
+test_case "Zig error set" "$BINARY --path testdata/zig/sample.zig --list --max-tokens 200" "error_set: BufferError"
+test_case "Zig test block" "$BINARY --path testdata/zig/sample.zig --list --max-tokens 200" "test: push and pop preserve order"
+echo ""
+
+echo "42. Dart File Tests"
+echo "----------------------------------------"
+test_case "Dart function takes its doc comment" "$BINARY --path testdata/dart/counter.dart --list --max-tokens 200" "Chunk 3/14 (lines 8-12): function: main"
+test_case "Dart small class stays whole" "$BINARY --path testdata/dart/counter.dart --list --max-tokens 200" "Chunk 6/14 (lines 19-28): class: CounterPage"
+test_case "Dart large class split at members" "$BINARY --path testdata/dart/counter.dart --list --max-tokens 200" "getter: atLimit"
+test_case "Dart build method with its annotation" "$BINARY --path testdata/dart/counter.dart --list --max-tokens 200" "Chunk 12/14 (lines 54-78): method: build"
+test_case "Dart extension" "$BINARY --path testdata/dart/counter.dart --list --max-tokens 200" "extension: CounterModeLabel"
+echo ""
+
+echo "43. Haskell File Tests"
+echo "----------------------------------------"
+test_case "Haskell module header and imports are the preamble" "$BINARY --path testdata/haskell/Shapes.hs --list --max-tokens 200" "Chunk 1/10 (lines 1-11): preamble"
+test_case "Haskell data type takes its Haddock comment" "$BINARY --path testdata/haskell/Shapes.hs --list --max-tokens 200" "Chunk 2/10 (lines 12-18): data: Shape"
+test_case "Haskell instance named by class and type" "$BINARY --path testdata/haskell/Shapes.hs --list --max-tokens 200" "instance: Drawable Shape"
+test_case "Haskell signature grouped with its equations" "$BINARY --path testdata/haskell/Shapes.hs --list --max-tokens 200" "Chunk 7/10 (lines 33-40): function: area"
+test_case "Haskell operator defined infix" "$BINARY --path testdata/haskell/Shapes.hs --list --max-tokens 200" "Chunk 10/10 (lines 50-51): function: <+>"
+echo ""
+
+echo "44. Same-Name Grouping Tests"
+echo "----------------------------------------"
+test_case "TS overloads move to the chunk of their implementation" "$BINARY --path testdata/typescript/overloads.ts --list --max-tokens 140" "Chunk 2/3 (lines 8-18): function: parse"
+test_case "Preceding declarations keep their own chunk" "$BINARY --path testdata/typescript/overloads.ts --list --max-tokens 140" "Chunk 1/3 (lines 1-7): code"
+echo ""
+
+echo "45. Chunk Coverage Tests"
+echo "----------------------------------------"
+test_case "Go chunks cover every line" "$BINARY --path testdata/golang/sample.go --validate --max-tokens 30" "valid:"
+test_case "TypeScript chunks cover every line" "$BINARY --path testdata/typescript/large.ts --validate --max-tokens 30" "valid:"
+test_case "Python chunks cover every line" "$BINARY --path testdata/python/sample.py --validate --max-tokens 30" "valid:"
+test_case "JavaScript chunks cover every line" "$BINARY --path testdata/javascript/sample.js --validate --max-tokens 30" "valid:"
+test_case "SQL chunks cover every line" "$BINARY --path testdata/sql/sample.sql --validate --max-tokens 30" "valid:"
+test_case "C# chunks cover every line" "$BINARY --path testdata/csharp/sample.cs --validate --max-tokens 30" "valid:"
+test_case "Ruby chunks cover every line" "$BINARY --path testdata/ruby/sample.rb --validate --max-tokens 30" "valid:"
+test_case "PHP chunks cover every line" "$BINARY --path testdata/php/sample.php --validate --max-tokens 30" "valid:"
+test_case "Kotlin chunks cover every line" "$BINARY --path testdata/kotlin/sample.kt --validate --max-tokens 30" "valid:"
+test_case "Swift chunks cover every line" "$BINARY --path testdata/swift/sample.swift --validate --max-tokens 30" "valid:"
+test_case "Protobuf chunks cover every line" "$BINARY --path testdata/proto/sample.proto --validate --max-tokens 30" "valid:"
+test_case "Elixir chunks cover every line" "$BINARY --path testdata/elixir/sample.ex --validate --max-tokens 30" "valid:"
+test_case "HTML chunks cover every line" "$BINARY --path testdata/html/sample.html --validate --max-tokens 30" "valid:"
+test_case "Go chunks cover every line at a larger budget" "$BINARY --path testdata/golang/sample.go --validate --max-tokens 200" "valid:"
+echo ""
+
+echo "46. Inter-Declaration Comment Tests"
+echo "----------------------------------------"
+test_case "License header is kept in the first chunk" "$BINARY --path testdata/golang/sections.go --chunk 0 --max-tokens 80" "Licensed under the Apache License"
+test_case "Section divider goes with the following function" "$BINARY --path testdata/golang/sections.go --list --max-tokens 80" "Chunk 5/6 (lines 31-39): function: Add"
+test_case "Section divider text is in the chunk" "$BINARY --path testdata/golang/sections.go --chunk 4 --max-tokens 80" "// Mutation"
+test_case "Detached block comment goes with the following function" "$BINARY --path testdata/golang/sections.go --chunk 5 --max-tokens 80" "Remove is kept apart from Add"
+test_case "Context is the declaration's own comment, not the divider" "$BINARY --path testdata/golang/sections.go --chunk 4 --max-tokens 80" "Context: Add increases"
+test_case "Chunks with comments between them cover every line" "$BINARY --path testdata/golang/sections.go --validate --max-tokens 80" "valid:"
+echo ""
+
+echo "47. File Header Tests"
+echo "----------------------------------------"
+test_case "License header is its own chunk" "$BINARY --path testdata/golang/sections.go --list --max-tokens 80" "Chunk 1/6 (lines 1-7): header"
+test_case "First declaration starts after the header" "$BINARY --path testdata/golang/sections.go --list --max-tokens 80" "Chunk 2/6 (lines 8-17): var: ErrNotFound"
+test_case "Python module docstring is the header" "$BINARY --path testdata/python/broken.py --list --max-tokens 30" "Chunk 1/4 (lines 1-1): header"
+test_case "File that fits in one chunk has no header" "$BINARY --path testdata/golang/sections.go --list" "Chunk 1/1 (lines 1-52): code"
+echo ""
+
+echo "48. TSX File Tests"
+echo "----------------------------------------"
+test_case "TSX component is chunked with the JSX grammar" "$BINARY --path testdata/typescript/Dashboard.tsx --list --max-tokens 60" "Chunk 2/9 (lines 15-18): code: Dashboard"
+test_case "TSX chunks cover every line" "$BINARY --path testdata/typescript/Dashboard.tsx --validate --max-tokens 60" "valid:"
+echo ""
+
+echo "49. JSX Grammar Tests"
+echo "----------------------------------------"
+test_case "Syntax errors are marked unparsed" "$BINARY --path testdata/python/broken.py --list --mark-unparsed --max-tokens 60" "unparsed"
+test_case "JSX file parses without syntax errors" "$BINARY --path testdata/javascript/TodoList.jsx --list --mark-unparsed --max-tokens 60 | grep -c unparsed" "^0$"
+test_case "TSX file parses without syntax errors" "$BINARY --path testdata/typescript/Dashboard.tsx --list --mark-unparsed --max-tokens 60 | grep -c unparsed" "^0$"
+test_case "JSX component is chunked" "$BINARY --path testdata/javascript/TodoList.jsx --list --max-tokens 100" "Chunk 1/6 (lines 1-11): function: TodoItem"
+echo ""
+
+echo "50. Adjacent Chunk Tests"
+echo "----------------------------------------"
+test_case "Chunk names the chunk after it" "$BINARY --path testdata/typescript/overloads.ts --chunk 0 --max-tokens 140" "Next: parse"
+test_case "Declaration chunk names the function after it" "$BINARY --path testdata/golang/sections.go --chunk 1 --max-tokens 80" "Next: Find"
+test_case "Chunk names the chunk before it" "$BINARY --path testdata/golang/sections.go --chunk 2 --max-tokens 80" "Previous: ErrNotFound"
+echo ""
+
+echo "51. Objective-C File Tests"
+echo "----------------------------------------"
+test_case "Objective-C implementation file parses" "$BINARY --path testdata/objc/AuthManager.m --list --max-tokens 1000" "implementation: AuthManager"
+test_case "Objective-C class extension is recognized" "$BINARY --path testdata/objc/AuthManager.m --list --max-tokens 1000" "extension: AuthManager"
+test_case "Objective-C C function is recognized" "$BINARY --path testdata/objc/AuthManager.m --list --max-tokens 1000" "function: IsValidEmail"
+test_case "Large implementation splits at methods" "$BINARY --path testdata/objc/AuthManager.m --list --max-tokens 150" "method: signInWithEmail:password:"
+test_case "Method chunk names its class" "$BINARY --path testdata/objc/AuthManager.m --chunk 8 --max-tokens 150" "Parent: AuthManager"
+test_case "Objective-C header protocol is recognized" "$BINARY --path testdata/objc/AuthManager.h --list --max-tokens 1000" "protocol: AuthManagerDelegate"
+test_case "Objective-C category is recognized" "$BINARY --path testdata/objc/AuthManager.h --list --max-tokens 1000" "category: AuthManager (Testing)"
+test_case "Objective-C NS_ENUM is recognized" "$BINARY --path testdata/objc/AuthManager.h --list --max-tokens 1000" "enum: AuthState"
+test_case "Plain C header stays text" "$BINARY --path testdata/objc/vector.h --list" "text"
+echo ""
+
+echo "52. Scala File Tests"
+echo "----------------------------------------"
+test_case "Scala file parses" "$BINARY --path testdata/scala/Inventory.scala --list --max-tokens 150" "Total chunks:"
+test_case "Scala trait is recognized" "$BINARY --path testdata/scala/Inventory.scala --list --max-tokens 60" "trait: Store"
+test_case "Scala object is recognized" "$BINARY --path testdata/scala/Inventory.scala --list --max-tokens 150" "object: Inventory"
+test_case "Large Scala class splits at its members" "$BINARY --path testdata/scala/Inventory.scala --list --max-tokens 150" "function: lowStock"
+test_case "Scala chunks cover every line" "$BINARY --path testdata/scala/Inventory.scala --validate --max-tokens 30" "valid:"
+echo ""
+
+echo "53. Perl File Tests"
+echo "----------------------------------------"
+test_case "Perl module parses" "$BINARY --path testdata/perl/Notifier.pm --list --max-tokens 300" "Total chunks:"
+test_case "Perl package is recognized" "$BINARY --path testdata/perl/Notifier.pm --list --max-tokens 300" "package: App::Notifier"
+test_case "Perl subroutine is recognized" "$BINARY --path testdata/perl/Notifier.pm --list --max-tokens 300" "function: enqueue"
+test_case "POD above a subroutine belongs to it" "$BINARY --path testdata/perl/Notifier.pm --list --max-tokens 300" "lines 14-30): function: new"
+test_case "Text after __END__ stays with the last subroutine" "$BINARY --path testdata/perl/Notifier.pm --list --max-tokens 300" "lines 63-73): function: send"
+echo ""
+
+echo "54. R File Tests"
+echo "----------------------------------------"
+test_case "R script parses" "$BINARY --path testdata/r/analysis.R --list --max-tokens 300" "Total chunks:"
+test_case "R function assignment is recognized" "$BINARY --path testdata/r/analysis.R --list --max-tokens 300" "function: summarise_sales"
+test_case "Roxygen comments belong to the function below" "$BINARY --path testdata/r/analysis.R --list --max-tokens 300" "lines 10-23): function: load_sales"
+test_case "Backquoted R operator is named" "$BINARY --path testdata/r/analysis.R --list --max-tokens 300" "function: %||%"
+test_case "Consecutive R assignments share a chunk" "$BINARY --path testdata/r/analysis.R --list --max-tokens 300" "lines 6-9): variable: DATA_DIR"
+echo ""
+
+echo "55. Julia File Tests"
+echo "----------------------------------------"
+test_case "Julia file parses" "$BINARY --path testdata/julia/Geometry.jl --list --max-tokens 300" "Total chunks:"
+test_case "Julia module is recognized" "$BINARY --path testdata/julia/Geometry.jl --list --max-tokens 300" "module: Geometry"
+test_case "Julia struct is recognized" "$BINARY --path testdata/julia/Geometry.jl --list --max-tokens 300" "struct: Circle"
+test_case "Julia macro is recognized" "$BINARY --path testdata/julia/Geometry.jl --list --max-tokens 300" "macro: approx"
+test_case "Short-form Julia function is recognized" "$BINARY --path testdata/julia/Geometry.jl --list --max-tokens 300" "function: norm2"
+test_case "Docstring belongs to the struct below" "$BINARY --path testdata/julia/Geometry.jl --list --max-tokens 300" "lines 12-21): struct: Point"
+echo ""
+
+echo "56. Chunk Order Tests"
+echo "----------------------------------------"
+test_case "Nested Python chunks are in line order" "$BINARY --path testdata/python/sample.py --list --max-tokens 30 | grep -o 'lines [0-9]*' | awk '{ if (\$2 < prev) bad = 1; prev = \$2 } END { print bad ? \"unsorted\" : \"sorted\" }'" "^sorted$"
+test_case "Nested TypeScript chunks are in line order" "$BINARY --path testdata/typescript/large.ts --list --max-tokens 30 | grep -o 'lines [0-9]*' | awk '{ if (\$2 < prev) bad = 1; prev = \$2 } END { print bad ? \"unsorted\" : \"sorted\" }'" "^sorted$"
+test_case "Nested Scala chunks are in line order" "$BINARY --path testdata/scala/Inventory.scala --list --max-tokens 60 | grep -o 'lines [0-9]*' | awk '{ if (\$2 < prev) bad = 1; prev = \$2 } END { print bad ? \"unsorted\" : \"sorted\" }'" "^sorted$"
+test_case "Split Objective-C chunks are in line order" "$BINARY --path testdata/objc/AuthManager.m --list --max-tokens 150 | grep -o 'lines [0-9]*' | awk '{ if (\$2 < prev) bad = 1; prev = \$2 } END { print bad ? \"unsorted\" : \"sorted\" }'" "^sorted$"
+echo ""
+
+echo "57. WebAssembly Text File Tests"
+echo "----------------------------------------"
+test_case "WAT file parses" "$BINARY --path testdata/wat/counter.wat --list --max-tokens 300" "Total chunks:"
+test_case "WAT function is named by its identifier" "$BINARY --path testdata/wat/counter.wat --list --max-tokens 300" "function: \$increment"
+test_case "WAT memory is recognized" "$BINARY --path testdata/wat/counter.wat --list --max-tokens 300" "memory: \$mem"
+test_case "WAT global is recognized" "$BINARY --path testdata/wat/counter.wat --list --max-tokens 300" "global: \$heap_top"
+test_case "Block comment belongs to the function below" "$BINARY --path testdata/wat/counter.wat --list --max-tokens 300" "lines 21-32): function: \$alloc"
+test_case "WAT imports share a chunk" "$BINARY --path testdata/wat/counter.wat --list --max-tokens 300" "lines 6-8): import: env.log"
+echo ""
+
+echo "58. INI, .env and Properties File Tests"
+echo "----------------------------------------"
+test_case "INI file parses" "$BINARY --path testdata/ini/settings.ini --list --max-tokens 300" "Total chunks:"
+test_case "INI section is named by its header" "$BINARY --path testdata/ini/settings.ini --list --max-tokens 300" "section: mail.smtp"
+test_case "Comment belongs to the section below" "$BINARY --path testdata/ini/settings.ini --list --max-tokens 300" "lines 5-11): section: database"
+test_case "Keys before the first section form the preamble" "$BINARY --path testdata/ini/settings.ini --list --max-tokens 300" "lines 1-4): preamble"
+test_case ".env blocks are split at blank lines" "$BINARY --path testdata/ini/.env.example --list --max-tokens 300" "block: REDIS_URL"
+test_case "Comment-only block leads into the block below" "$BINARY --path testdata/ini/.env.example --list --max-tokens 300" "lines 1-6): block: DATABASE_URL"
+test_case "Properties block is named by its first key" "$BINARY --path testdata/ini/messages.properties --list --max-tokens 300" "block: checkout.title"
+echo ""
+
+echo "59. One Chunk Per Symbol Tests"
+echo "----------------------------------------"
+test_case "Small declarations are packed by default" "$BINARY --path testdata/golang/sections.go --list --max-tokens 2000" "Total chunks: 1"
+test_case "Every declaration gets its own chunk" "$BINARY --path testdata/golang/sections.go --list --max-tokens 2000 --one-per-symbol" "lines 31-39): function: Add"
+test_case "Methods are chunked apart from their type" "$BINARY --path testdata/golang/sample.go --list --max-tokens 2000 --one-per-symbol" "lines 45-52): method: Delete"
+test_case "Per-symbol chunks cover the file" "$BINARY --path testdata/golang/sample.go --validate --one-per-symbol" "valid: 17 chunks cover 130 lines"
+echo ""
+
+echo "60. Line Range Tests"
+echo "----------------------------------------"
+test_case "Last Dockerfile stage ends on the last line" "$BINARY --path testdata/dockerfile/Dockerfile --validate --max-tokens 100" "valid: 5 chunks cover 30 lines"
+test_case "Last Perl subroutine ends on the last line" "$BINARY --path testdata/perl/Notifier.pm --validate --max-tokens 100" "valid: 9 chunks cover 73 lines"
+test_case "Last INI section ends on the last line" "$BINARY --path testdata/ini/settings.ini --validate --max-tokens 100" "valid: 4 chunks cover 20 lines"
+test_case "Split markdown section ends on the last line" "$BINARY --path testdata/markdown/lists.md --validate --max-tokens 100" "valid: 2 chunks cover 26 lines"
+test_case "Whitespace-only file chunk ends on the last line" "$BINARY --path testdata/empty/whitespace.ts --validate" "valid: 1 chunks cover 3 lines"
+echo ""
+
+echo "61. Context Truncation Tests"
+echo "----------------------------------------"
+test_case "Long comment context ends in an ellipsis" "$BINARY --path testdata/perl/Notifier.pm --list --max-tokens 300" "^  Sends everything queued, keeping failures for the next fl\.\.\.$"
+test_case "Long markdown context ends in an ellipsis" "$BINARY --path testdata/markdown/fenced.md --list --max-tokens 300" "^  After the script finishes, verify the health endpoint on \.\.\.$"
+test_case "Accented and emoji context is cut between characters" "$BINARY --path testdata/python/unicode.py --list" "^  🍮 Crème brûlée façade 🎉: naïve résumé parsing for café me\.\.\.$"
+test_case "Chunk header cuts context between characters" "$BINARY --path testdata/python/unicode.py --chunk 0" "Context: 🍮 Crème brûlée façade 🎉: naïve résumé par\.\.\.│"
+echo ""
+
+echo "62. SCSS and LESS Import Tests"
+echo "----------------------------------------"
+test_case "SCSS @use and @import form an imports chunk" "$BINARY --path testdata/css/sample.scss --list --max-tokens 80" "lines 1-2): imports"
+test_case "Rule after the imports starts its own chunk" "$BINARY --path testdata/css/sample.scss --list --max-tokens 80" "lines 3-8): mixin: @mixin card"
+test_case "LESS file parses" "$BINARY --path testdata/css/theme.less --list --max-tokens 30" "Total chunks:"
+test_case "LESS imports take the file comment" "$BINARY --path testdata/css/theme.less --list --max-tokens 30" "lines 1-3): imports"
+test_case "Imports chunk lists the directives" "$BINARY --path testdata/css/theme.less --list --max-tokens 30" "^  @import (reference) \"mixins.less\"; @import \"variables\";"
+test_case "LESS variable is recognized" "$BINARY --path testdata/css/theme.less --list --max-tokens 30" "variable: @primary"
+test_case "LESS chunks cover the file" "$BINARY --path testdata/css/theme.less --validate --max-tokens 30" "valid: 5 chunks cover 26 lines"
+test_case "Whole-file chunk starts after the imports" "$BINARY --path testdata/css/theme.less --list" "lines 4-26): code"
+test_case "Import lines appear in one chunk only" "$BINARY --path testdata/css/theme.less --chunk 1 | grep -c '@import'" "^0$"
+test_case "SCSS chunks cover the file at the default budget" "$BINARY --path testdata/css/sample.scss --validate" "valid: 2 chunks cover 57 lines"
+test_case "LESS chunks cover the file at the default budget" "$BINARY --path testdata/css/theme.less --validate" "valid: 2 chunks cover 26 lines"
+echo ""
+
+# context_of writes its second argument, with printf escapes such as \n, to
+# a scratch file at the path given first, chunks it with any further flags
+# and prints the context line of chunk $3, or of the first chunk
+CONTEXT_DIR=/tmp/progressive-reader-context
+context_of() {
+    local file="$CONTEXT_DIR/$1" chunk="${3:-1}"
+    mkdir -p "$(dirname "$file")"
+    printf '%b' "$2" > "$file"
+    $BINARY --path "$file" --list "${@:4}" | sed -n "/^Chunk $chunk\\//{n;p;q;}"
+}
+
+echo "63. Boilerplate Prefix Tests"
+echo "----------------------------------------"
+test_case "Go package is skipped" "context_of 1/main.go 'package main\\nfunc main() {}\\n'" "^  func main() {}"
+test_case "Go import is skipped" "context_of 2/main.go 'import \"fmt\"\\nfunc main() {}\\n'" "^  func main() {}"
+test_case "Python import is skipped" "context_of 3/app.py 'import os\\nx = 1\\n'" "^  x = 1"
+test_case "Python from is skipped" "context_of 4/app.py 'from os import path\\nx = 1\\n'" "^  x = 1"
+test_case "Python #! is skipped" "context_of 5/app.py '#!/usr/bin/env python3\\nx = 1\\n'" "^  x = 1"
+test_case "TypeScript import is skipped" "context_of 6/app.ts 'import { a } from \"./a\"\\nconst x = 1;\\n'" "^  const x = 1;"
+test_case "TypeScript \"use strict\" is skipped" "context_of 7/app.ts '\"use strict\"\\nconst x = 1;\\n'" "^  const x = 1;"
+test_case "TypeScript 'use strict' is skipped" "context_of 8/app.ts '\\x27use strict\\x27\\nconst x = 1;\\n'" "^  const x = 1;"
+test_case "JavaScript import is skipped" "context_of 9/app.js 'import { a } from \"./a\"\\nconst x = 1;\\n'" "^  const x = 1;"
+test_case "JavaScript \"use strict\" is skipped" "context_of 10/app.js '\"use strict\"\\nconst x = 1;\\n'" "^  const x = 1;"
+test_case "JavaScript 'use strict' is skipped" "context_of 11/app.js '\\x27use strict\\x27\\nconst x = 1;\\n'" "^  const x = 1;"
+test_case "Ruby require is skipped" "context_of 12/app.rb 'require \"json\"\\nx = 1\\n'" "^  x = 1"
+test_case "Ruby require_relative is skipped" "context_of 13/app.rb 'require_relative \"lib\"\\nx = 1\\n'" "^  x = 1"
+test_case "Ruby #! is skipped" "context_of 14/app.rb '#!/usr/bin/env ruby\\nx = 1\\n'" "^  x = 1"
+test_case "Ruby # frozen_string_literal is skipped" "context_of 15/app.rb '# frozen_string_literal: true\\nx = 1\\n'" "^  x = 1"
+test_case "PHP <?php is skipped" "context_of 16/app.php '<?php\\necho 1;\\n'" "^  echo 1;"
+test_case "PHP namespace is skipped" "context_of 17/app.php '<?php\\nnamespace App;\\necho 1;\\n'" "^  echo 1;"
+test_case "PHP use is skipped" "context_of 18/app.php '<?php\\nuse Foo;\\necho 1;\\n'" "^  echo 1;"
+test_case "PHP require is skipped" "context_of 19/app.php '<?php\\nrequire \"a.php\";\\necho 1;\\n'" "^  echo 1;"
+test_case "PHP include is skipped" "context_of 20/app.php '<?php\\ninclude \"a.php\";\\necho 1;\\n'" "^  echo 1;"
+test_case "PHP declare( is skipped" "context_of 21/app.php '<?php\\ndeclare(strict_types=1);\\necho 1;\\n'" "^  echo 1;"
+test_case "C# using is skipped" "context_of 22/App.cs 'using System;\\nclass A {}\\n'" "^  class A {}"
+test_case "Kotlin package is skipped" "context_of 23/App.kt 'package app\\nval x = 1\\n'" "^  val x = 1"
+test_case "Kotlin import is skipped" "context_of 24/App.kt 'import a.B\\nval x = 1\\n'" "^  val x = 1"
+test_case "Scala package is skipped" "context_of 25/App.scala 'package app\\nval x = 1\\n'" "^  val x = 1"
+test_case "Scala import is skipped" "context_of 26/App.scala 'import a.B\\nval x = 1\\n'" "^  val x = 1"
+test_case "Swift import is skipped" "context_of 27/App.swift 'import Foundation\\nlet x = 1\\n'" "^  let x = 1"
+test_case "Dart import is skipped" "context_of 28/app.dart 'import \"a.dart\";\\nvar x = 1;\\n'" "^  Code chunk"
+test_case "Dart export is skipped" "context_of 29/app.dart 'export \"a.dart\";\\nvar x = 1;\\n'" "^  Code chunk"
+test_case "Dart part is skipped" "context_of 30/app.dart 'part \"a.dart\";\\nvar x = 1;\\n'" "^  Code chunk"
+test_case "Dart library is skipped" "context_of 31/app.dart 'library app;\\nvar x = 1;\\n'" "^  Code chunk"
+test_case "Dart @override is skipped" "context_of 32/app.dart '@override\\nvar x = 1;\\n'" "^  var x = 1;"
+test_case "Haskell module is skipped" "context_of 33/App.hs 'module Main where\\nx = 1\\n'" "^  Code chunk"
+test_case "Haskell import is skipped" "context_of 34/App.hs 'import Data.List\\nx = 1\\n'" "^  Code chunk"
+test_case "Haskell {-# is skipped" "context_of 35/App.hs '{-# LANGUAGE GADTs #-}\\nx = 1\\n'" "^  x = 1"
+test_case "Objective-C #import is skipped" "context_of 36/App.m '#import <Foundation/Foundation.h>\\nint x = 1;\\n'" "^  Code chunk"
+test_case "Objective-C #include is skipped" "context_of 37/App.m '#include <stdio.h>\\nint x = 1;\\n'" "^  Code chunk"
+test_case "Objective-C @import is skipped" "context_of 38/App.m '@import Foundation;\\nint x = 1;\\n'" "^  Code chunk"
+test_case "Objective-C @class is skipped" "context_of 39/App.m '@class Widget;\\nint x = 1;\\n'" "^  Code chunk"
+test_case "Objective-C NS_ASSUME_NONNULL_ is skipped" "context_of 40/App.m 'NS_ASSUME_NONNULL_BEGIN\\nint x = 1;\\n'" "^  Code chunk"
+test_case "Perl #! is skipped" "context_of 41/App.pm '#!/usr/bin/perl\\nmy @x = (1);\\n'" "^  my @x = (1);"
+test_case "Perl use is skipped" "context_of 42/App.pm 'use strict;\\nmy @x = (1);\\n'" "^  my @x = (1);"
+test_case "Perl no is skipped" "context_of 43/App.pm 'no warnings;\\nmy @x = (1);\\n'" "^  my @x = (1);"
+test_case "Perl require is skipped" "context_of 44/App.pm 'require Foo;\\nmy @x = (1);\\n'" "^  my @x = (1);"
+test_case "R #! is skipped" "context_of 45/app.r '#!/usr/bin/env Rscript\\nx <- 1\\n'" "^  Code chunk"
+test_case "R library( is skipped" "context_of 46/app.r 'library(dplyr)\\nx <- 1\\n'" "^  Code chunk"
+test_case "R require( is skipped" "context_of 47/app.r 'require(dplyr)\\nx <- 1\\n'" "^  Code chunk"
+test_case "R source( is skipped" "context_of 48/app.r 'source(\"a.R\")\\nx <- 1\\n'" "^  Code chunk"
+test_case "Julia using is skipped" "context_of 49/app.jl 'using LinearAlgebra\\nx = 1\\n'" "^  x = 1"
+test_case "Julia import is skipped" "context_of 50/app.jl 'import Base\\nx = 1\\n'" "^  x = 1"
+test_case "Julia export is skipped" "context_of 51/app.jl 'export area\\nx = 1\\n'" "^  x = 1"
+test_case "Julia include( is skipped" "context_of 52/app.jl 'include(\"a.jl\")\\nx = 1\\n'" "^  x = 1"
+test_case "Elixir alias is skipped" "context_of 53/app.ex 'alias Foo.Bar\\nx = 1\\n'" "^  x = 1"
+test_case "Elixir import is skipped" "context_of 54/app.ex 'import Foo\\nx = 1\\n'" "^  x = 1"
+test_case "Elixir require is skipped" "context_of 55/app.ex 'require Logger\\nx = 1\\n'" "^  x = 1"
+test_case "Elixir use is skipped" "context_of 56/app.ex 'use GenServer\\nx = 1\\n'" "^  x = 1"
+test_case "Lua require is skipped" "context_of 57/app.lua 'require(\"a\")\\nlocal x = 1\\n'" "^  local x = 1"
+test_case "Bash #! is skipped" "context_of 58/app.sh '#!/bin/bash\\nx=1\\n'" "^  x=1"
+test_case "Bash set - is skipped" "context_of 59/app.sh 'set -euo pipefail\\nx=1\\n'" "^  x=1"
+test_case "Protobuf syntax is skipped" "context_of 60/app.proto 'syntax = \"proto3\";\\nmessage A {}\\n'" "^  message A {}"
+test_case "Protobuf package is skipped" "context_of 61/app.proto 'package app;\\nmessage A {}\\n'" "^  message A {}"
+test_case "Protobuf import is skipped" "context_of 62/app.proto 'import \"a.proto\";\\nmessage A {}\\n'" "^  message A {}"
+test_case "SCSS @use is skipped" "context_of 63/app.scss '.a { color: red; }\\n@use \"a\";\\n.a { color: red; }\\n' 2 --max-tokens 5" "^  Code chunk"
+test_case "SCSS @import is skipped" "context_of 64/app.scss '.a { color: red; }\\n@import \"a\";\\n.a { color: red; }\\n' 2 --max-tokens 5" "^  Code chunk"
+test_case "SCSS @forward is skipped" "context_of 65/app.scss '.a { color: red; }\\n@forward \"a\";\\n.a { color: red; }\\n' 2 --max-tokens 5" "^  Code chunk"
+test_case "LESS @import is skipped" "context_of 66/app.less '.a { color: red; }\\n@import \"a\";\\n.a { color: red; }\\n' 2 --max-tokens 5" "^  Code chunk"
+test_case "CSS @import is skipped" "context_of 67/app.css '@import \"a.css\";\\n.a { color: red; }\\n'" "^  \\.a { color: red; }"
+test_case "CSS @charset is skipped" "context_of 68/app.css '@charset \"utf-8\";\\n.a { color: red; }\\n'" "^  \\.a { color: red; }"
+test_case "Dockerfile # syntax= is skipped" "context_of 69/Dockerfile '# syntax=docker/dockerfile:1\\nFROM alpine\\n'" "^  FROM alpine"
+test_case "Dockerfile # escape= is skipped" "context_of 70/Dockerfile '# escape=\\\\\\nFROM alpine\\n'" "^  FROM alpine"
+echo ""
+
+echo "64. Comment Marker Tests"
+echo "----------------------------------------"
+test_case "Python \"\"\" comment is the context" "context_of 71/app.py '\"\"\"Explains the code\"\"\"\\nx = 1\\n'" "^  Explains the code"
+test_case "Python ''' comment is the context" "context_of 72/app.py '\\x27\\x27\\x27Explains the code\\x27\\x27\\x27\\nx = 1\\n'" "^  Explains the code"
+test_case "Python # comment is the context" "context_of 73/app.py '# Explains the code\\nx = 1\\n'" "^  Explains the code"
+test_case "Ruby # comment is the context" "context_of 74/app.rb '# Explains the code\\nx = 1\\n'" "^  Explains the code"
+test_case "Bash # comment is the context" "context_of 75/app.sh '# Explains the code\\nx=1\\n'" "^  Explains the code"
+test_case "YAML # comment is the context" "context_of 76/app.yaml '# Explains the code\\na: 1\\n'" "^  Explains the code"
+test_case "Dockerfile # comment is the context" "context_of 77/Dockerfile '# Explains the code\\nFROM alpine\\n'" "^  Explains the code"
+test_case "GraphQL \"\"\" comment is the context" "context_of 78/app.graphql '\"\"\"Explains the code\"\"\"\\ntype A { id: ID }\\n'" "^  Explains the code"
+test_case "GraphQL # comment is the context" "context_of 79/app.graphql '# Explains the code\\ntype A { id: ID }\\n'" "^  Explains the code"
+test_case "GraphQL \" comment is the context" "context_of 80/app.graphql '\"Explains the code\"\\ntype A { id: ID }\\n'" "^  Explains the code"
+test_case "Elixir @moduledoc comment is the context" "context_of 81/app.ex '@moduledoc \"Explains the code\"\\nx = 1\\n'" "^  Explains the code"
+test_case "Elixir @doc comment is the context" "context_of 82/app.ex '@doc \"Explains the code\"\\nx = 1\\n'" "^  Explains the code"
+test_case "Elixir # comment is the context" "context_of 83/app.ex '# Explains the code\\nx = 1\\n'" "^  Explains the code"
+test_case "Lua --[[ comment is the context" "context_of 84/app.lua '--[[ Explains the code ]]\\nlocal x = 1\\n'" "^  Explains the code"
+test_case "Lua -- comment is the context" "context_of 85/app.lua '-- Explains the code\\nlocal x = 1\\n'" "^  Explains the code"
+test_case "Zig /// comment is the context" "context_of 86/app.zig '/// Explains the code\\nconst x = 1;\\n'" "^  Explains the code"
+test_case "Zig //! comment is the context" "context_of 87/app.zig '//! Explains the code\\nconst x = 1;\\n'" "^  Explains the code"
+test_case "Zig // comment is the context" "context_of 88/app.zig '// Explains the code\\nconst x = 1;\\n'" "^  Explains the code"
+test_case "Dart /// comment is the context" "context_of 89/app.dart '/// Explains the code\\nvar x = 1;\\n'" "^  Explains the code"
+test_case "Dart // comment is the context" "context_of 90/app.dart '// Explains the code\\nvar x = 1;\\n'" "^  Explains the code"
+test_case "Dart /** comment is the context" "context_of 91/app.dart '/** Explains the code */\\nvar x = 1;\\n'" "^  Explains the code"
+test_case "Dart /* comment is the context" "context_of 92/app.dart '/* Explains the code */\\nvar x = 1;\\n'" "^  Explains the code"
+test_case "Dart * comment is the context" "context_of 93/app.dart '/*\\n * Explains the code\\n */\\nvar x = 1;\\n'" "^  Explains the code"
+test_case "Objective-C /// comment is the context" "context_of 94/App.m '/// Explains the code\\nint x = 1;\\n'" "^  Explains the code"
+test_case "Objective-C // comment is the context" "context_of 95/App.m '// Explains the code\\nint x = 1;\\n'" "^  Explains the code"
+test_case "Objective-C /** comment is the context" "context_of 96/App.m '/** Explains the code */\\nint x = 1;\\n'" "^  Explains the code"
+test_case "Objective-C /* comment is the context" "context_of 97/App.m '/* Explains the code */\\nint x = 1;\\n'" "^  Explains the code"
+test_case "Objective-C * comment is the context" "context_of 98/App.m '/*\\n * Explains the code\\n */\\nint x = 1;\\n'" "^  Explains the code"
+test_case "Haskell -- | comment is the context" "context_of 99/App.hs '-- | Explains the code\\nx = 1\\n'" "^  Explains the code"
+test_case "Haskell -- ^ comment is the context" "context_of 100/App.hs '-- ^ Explains the code\\nx = 1\\n'" "^  Explains the code"
+test_case "Haskell -- comment is the context" "context_of 101/App.hs '-- Explains the code\\nx = 1\\n'" "^  Explains the code"
+test_case "Haskell {-| comment is the context" "context_of 102/App.hs '{-| Explains the code -}\\nx = 1\\n'" "^  Explains the code"
+test_case "Haskell {- comment is the context" "context_of 103/App.hs '{- Explains the code -}\\nx = 1\\n'" "^  Explains the code"
+test_case "Perl # comment is the context" "context_of 104/App.pm '# Explains the code\\nmy @x = (1);\\n'" "^  Explains the code"
+test_case "R #' comment is the context" "context_of 105/app.r '#\\x27 Explains the code\\nx <- 1\\n'" "^  Explains the code"
+test_case "R # comment is the context" "context_of 106/app.r '# Explains the code\\nx <- 1\\n'" "^  Explains the code"
+test_case "Julia \"\"\" comment is the context" "context_of 107/app.jl '\"\"\"Explains the code\"\"\"\\nx = 1\\n'" "^  Explains the code"
+test_case "Julia #= comment is the context" "context_of 108/app.jl '#= Explains the code =#\\nx = 1\\n'" "^  Explains the code"
+test_case "Julia # comment is the context" "context_of 109/app.jl '# Explains the code\\nx = 1\\n'" "^  Explains the code"
+test_case "WAT ;; comment is the context" "context_of 110/app.wat ';; Explains the code\\n(module)\\n'" "^  Explains the code"
+test_case "WAT (; comment is the context" "context_of 111/app.wat '(; Explains the code ;)\\n(module)\\n'" "^  Explains the code"
+test_case "INI ; comment is the context" "context_of 112/app.ini '; Explains the code\\n[server]\\n'" "^  Explains the code"
+test_case "INI # comment is the context" "context_of 113/app.ini '# Explains the code\\n[server]\\n'" "^  Explains the code"
+test_case ".env # comment is the context" "context_of 114/.env '# Explains the code\\nA=1\\n'" "^  Explains the code"
+test_case "Properties # comment is the context" "context_of 115/app.properties '# Explains the code\\na=1\\n'" "^  Explains the code"
+test_case "Properties ! comment is the context" "context_of 116/app.properties '! Explains the code\\na=1\\n'" "^  Explains the code"
+test_case "SQL -- comment is the context" "context_of 117/app.sql '-- Explains the code\\nSELECT 1;\\n'" "^  Explains the code"
+test_case "SQL /** comment is the context" "context_of 118/app.sql '/** Explains the code */\\nSELECT 1;\\n'" "^  Explains the code"
+test_case "SQL /* comment is the context" "context_of 119/app.sql '/* Explains the code */\\nSELECT 1;\\n'" "^  Explains the code"
+test_case "SQL * comment is the context" "context_of 120/app.sql '/*\\n * Explains the code\\n */\\nSELECT 1;\\n'" "^  Explains the code"
+test_case "HCL # comment is the context" "context_of 121/main.tf '# Explains the code\\nlocals {}\\n'" "^  Explains the code"
+test_case "HCL // comment is the context" "context_of 122/main.tf '// Explains the code\\nlocals {}\\n'" "^  Explains the code"
+test_case "HCL /** comment is the context" "context_of 123/main.tf '/** Explains the code */\\nlocals {}\\n'" "^  Explains the code"
+test_case "HCL /* comment is the context" "context_of 124/main.tf '/* Explains the code */\\nlocals {}\\n'" "^  Explains the code"
+test_case "HCL * comment is the context" "context_of 125/main.tf '/*\\n * Explains the code\\n */\\nlocals {}\\n'" "^  Explains the code"
+test_case "PHP // comment is the context" "context_of 126/app.php '<?php\\n// Explains the code\\necho 1;\\n'" "^  Explains the code"
+test_case "PHP /* comment is the context" "context_of 127/app.php '<?php\\n/* Explains the code */\\necho 1;\\n'" "^  Explains the code"
+test_case "PHP * comment is the context" "context_of 128/app.php '<?php\\n/*\\n * Explains the code\\n */\\necho 1;\\n'" "^  Explains the code"
+test_case "PHP # comment is the context" "context_of 129/app.php '<?php\\n# Explains the code\\necho 1;\\n'" "^  Explains the code"
+test_case "HTML <!-- comment is the context" "context_of 130/index.html '<!-- Explains the code -->\\n<p>hi</p>\\n'" "^  Explains the code"
 echo ""
+rm -rf "$CONTEXT_DIR"
 
 echo "========================================"
 echo "Test Results"
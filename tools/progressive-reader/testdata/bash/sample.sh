@@ -0,0 +1,32 @@
+#!/bin/bash
+set -euo pipefail
+
+BACKUP_DIR="${BACKUP_DIR:-/var/backups/app}"
+RETENTION_DAYS=7
+
+log() {
+    echo "[$(date '+%Y-%m-%d %H:%M:%S')] $*" >&2
+}
+
+function create_backup {
+    local target="$1"
+    local stamp
+    stamp=$(date +%Y%m%d%H%M%S)
+
+    mkdir -p "$BACKUP_DIR"
+    tar -czf "$BACKUP_DIR/backup-$stamp.tar.gz" "$target"
+    log "Created backup-$stamp.tar.gz"
+}
+
+prune_backups() {
+    find "$BACKUP_DIR" -name 'backup-*.tar.gz' -mtime +"$RETENTION_DAYS" -delete
+    log "Pruned backups older than $RETENTION_DAYS days"
+}
+
+if [ $# -lt 1 ]; then
+    echo "Usage: $0 <directory>"
+    exit 1
+fi
+
+create_backup "$1"
+prune_backups
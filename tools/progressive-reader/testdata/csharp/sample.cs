@@ -0,0 +1,87 @@
+using System;
+using System.Collections.Generic;
+using System.Linq;
+
+namespace Capsule.Inventory
+{
+    /// <summary>
+    /// Repository abstraction over the item store.
+    /// </summary>
+    public interface IItemRepository
+    {
+        Item Find(int id);
+        IEnumerable<Item> All();
+        void Save(Item item);
+    }
+
+    public record Item(int Id, string Name, decimal Price);
+
+    public enum StockLevel
+    {
+        Empty,
+        Low,
+        Plenty,
+    }
+
+    public struct Money
+    {
+        public decimal Amount;
+        public string Currency;
+
+        public override string ToString() => $"{Amount} {Currency}";
+    }
+
+    public class InMemoryItemRepository : IItemRepository
+    {
+        private readonly Dictionary<int, Item> _items = new Dictionary<int, Item>();
+
+        public int Count => _items.Count;
+
+        public InMemoryItemRepository(IEnumerable<Item> seed)
+        {
+            foreach (var item in seed)
+            {
+                _items[item.Id] = item;
+            }
+        }
+
+        public Item Find(int id)
+        {
+            if (!_items.TryGetValue(id, out var item))
+            {
+                throw new KeyNotFoundException($"item {id} not found");
+            }
+            return item;
+        }
+
+        public IEnumerable<Item> All()
+        {
+            return _items.Values.OrderBy(i => i.Id);
+        }
+
+        public void Save(Item item)
+        {
+            _items[item.Id] = item;
+        }
+    }
+
+    public class StockService
+    {
+        private readonly IItemRepository _repository;
+
+        public StockService(IItemRepository repository)
+        {
+            _repository = repository;
+        }
+
+        public StockLevel LevelFor(int id, int quantity)
+        {
+            var item = _repository.Find(id);
+            if (quantity == 0)
+            {
+                return StockLevel.Empty;
+            }
+            return quantity < 10 ? StockLevel.Low : StockLevel.Plenty;
+        }
+    }
+}
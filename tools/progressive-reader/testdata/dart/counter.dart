@@ -0,0 +1,89 @@
+import 'package:flutter/material.dart';
+
+import 'theme.dart';
+
+const kMaxCount = 99;
+const kMinCount = 0;
+
+/// Entry point for the counter demo.
+void main() {
+  runApp(const CounterApp());
+}
+
+enum CounterMode { increment, decrement }
+
+mixin Logging {
+  void log(String message) => debugPrint('[counter] $message');
+}
+
+/// Shows a count and buttons to change it.
+class CounterPage extends StatefulWidget {
+  const CounterPage({super.key, required this.title});
+
+  final String title;
+
+  @override
+  State<CounterPage> createState() => _CounterPageState();
+}
+
+class _CounterPageState extends State<CounterPage> with Logging {
+  int _count = 0;
+  CounterMode _mode = CounterMode.increment;
+
+  bool get atLimit => _count >= kMaxCount || _count <= kMinCount;
+
+  void _step() {
+    setState(() {
+      if (_mode == CounterMode.increment) {
+        _count = (_count + 1).clamp(kMinCount, kMaxCount);
+      } else {
+        _count = (_count - 1).clamp(kMinCount, kMaxCount);
+      }
+    });
+    log('count is now $_count');
+  }
+
+  void _toggleMode() {
+    setState(() {
+      _mode = _mode == CounterMode.increment
+          ? CounterMode.decrement
+          : CounterMode.increment;
+    });
+  }
+
+  @override
+  Widget build(BuildContext context) {
+    final theme = Theme.of(context);
+    return Scaffold(
+      appBar: AppBar(
+        title: Text(widget.title),
+        actions: [
+          IconButton(
+            icon: Icon(_mode == CounterMode.increment ? Icons.add : Icons.remove),
+            onPressed: _toggleMode,
+          ),
+        ],
+      ),
+      body: Center(
+        child: Column(
+          mainAxisAlignment: MainAxisAlignment.center,
+          children: [
+            Text('You have pressed the button this many times:',
+                style: theme.textTheme.bodyLarge),
+            Text('$_count', style: theme.textTheme.headlineMedium),
+            if (atLimit) const Text('Limit reached'),
+          ],
+        ),
+      ),
+      floatingActionButton: FloatingActionButton(
+        onPressed: atLimit ? null : _step,
+        tooltip: 'Step',
+        child: const Icon(Icons.exposure),
+      ),
+    );
+  }
+}
+
+extension CounterModeLabel on CounterMode {
+  String get label => this == CounterMode.increment ? 'Up' : 'Down';
+}
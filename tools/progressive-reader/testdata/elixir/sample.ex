@@ -0,0 +1,51 @@
+defmodule MyApp.Accounts do
+  @moduledoc """
+  Account management: registration, lookup and authentication.
+  """
+
+  alias MyApp.Repo
+  alias MyApp.Accounts.User
+
+  @doc "Returns the user with the given id, or nil."
+  def get_user(id) do
+    Repo.get(User, id)
+  end
+
+  @doc "Registers a new user from the given attributes."
+  def register(attrs \\ %{}) do
+    %User{}
+    |> User.changeset(attrs)
+    |> Repo.insert()
+  end
+
+  def authenticate(email, password) when is_binary(email) do
+    user = Repo.get_by(User, email: email)
+
+    if user && verify_password(password, user.password_hash) do
+      {:ok, user}
+    else
+      {:error, :invalid_credentials}
+    end
+  end
+
+  defp verify_password(password, hash) do
+    Bcrypt.verify_pass(password, hash)
+  end
+
+  defmacro with_user(id, do: block) do
+    quote do
+      case get_user(unquote(id)) do
+        nil -> {:error, :not_found}
+        var!(user) -> unquote(block)
+      end
+    end
+  end
+
+  defmodule User do
+    defstruct [:id, :email, :password_hash]
+
+    def changeset(user, attrs) do
+      Map.merge(user, attrs)
+    end
+  end
+end
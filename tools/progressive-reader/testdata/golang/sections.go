@@ -0,0 +1,52 @@
+// Copyright 2024 The Inventory Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+package inventory
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrNotFound is returned when an item is missing.
+var ErrNotFound = errors.New("inventory: not found")
+
+// ---------------------------------------------------------------------------
+// Lookup
+// ---------------------------------------------------------------------------
+
+// Find returns the quantity stored for name.
+func Find(stock map[string]int, name string) (int, error) {
+	n, ok := stock[name]
+	if !ok {
+		return 0, fmt.Errorf("%s: %w", name, ErrNotFound)
+	}
+	return n, nil
+}
+
+// ---------------------------------------------------------------------------
+// Mutation
+// ---------------------------------------------------------------------------
+
+// Add increases the quantity stored for name by n.
+func Add(stock map[string]int, name string, n int) {
+	stock[name] += n
+}
+
+/*
+ * Remove is kept apart from Add because it can fail.
+ */
+
+// Remove decreases the quantity stored for name by n.
+func Remove(stock map[string]int, name string, n int) error {
+	if stock[name] < n {
+		return ErrNotFound
+	}
+	stock[name] -= n
+	return nil
+}
@@ -0,0 +1,51 @@
+{-# LANGUAGE ScopedTypeVariables #-}
+-- | Geometry helpers for the layout engine.
+module Shapes
+  ( Shape (..)
+  , area
+  , perimeter
+  ) where
+
+import Data.List (sortOn)
+import qualified Data.Map as Map
+
+-- | A shape positioned at the origin.
+data Shape
+  = Circle Double
+  | Rect Double Double
+  | Triangle Double Double Double
+  deriving (Show, Eq)
+
+newtype Name = Name String
+
+type Registry = Map.Map String Shape
+
+-- | Things that can be drawn to a terminal.
+class Drawable a where
+  draw :: a -> String
+  draw _ = "?"
+
+instance Drawable Shape where
+  draw (Circle r) = "circle " ++ show r
+  draw (Rect w h) = "rect " ++ show w ++ "x" ++ show h
+  draw Triangle {} = "triangle"
+
+-- | Area of a shape.
+area :: Shape -> Double
+area (Circle r) = pi * r * r
+area (Rect w h) = w * h
+area (Triangle a b c) =
+  let s = (a + b + c) / 2
+   in sqrt (s * (s - a) * (s - b) * (s - c))
+
+perimeter :: Shape -> Double
+perimeter (Circle r) = 2 * pi * r
+perimeter (Rect w h) = 2 * (w + h)
+perimeter (Triangle a b c) = a + b + c
+
+-- | Shapes ordered from smallest to largest.
+bySize :: [Shape] -> [Shape]
+bySize = sortOn area
+
+(<+>) :: Shape -> Shape -> Double
+a <+> b = area a + area b
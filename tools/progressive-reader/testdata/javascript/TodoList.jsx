@@ -0,0 +1,55 @@
+import React, { useState } from "react";
+
+function TodoItem({ todo, onToggle, onRemove }) {
+  return (
+    <li className={todo.done ? "todo done" : "todo"}>
+      <input type="checkbox" checked={todo.done} onChange={() => onToggle(todo.id)} />
+      <span>{todo.title}</span>
+      <button onClick={() => onRemove(todo.id)} aria-label={`Remove ${todo.title}`}>
+        &times;
+      </button>
+    </li>
+  );
+}
+
+const EmptyState = ({ message = "Nothing to do" }) => (
+  <p className="empty">{message}</p>
+);
+
+export default function TodoList({ initial = [] }) {
+  const [todos, setTodos] = useState(initial);
+  const [draft, setDraft] = useState("");
+
+  const add = (e) => {
+    e.preventDefault();
+    if (!draft.trim()) return;
+    setTodos([...todos, { id: Date.now(), title: draft, done: false }]);
+    setDraft("");
+  };
+
+  const toggle = (id) =>
+    setTodos(todos.map((t) => (t.id === id ? { ...t, done: !t.done } : t)));
+
+  const remove = (id) => setTodos(todos.filter((t) => t.id !== id));
+
+  return (
+    <>
+      <form onSubmit={add}>
+        <input value={draft} onChange={(e) => setDraft(e.target.value)} {...{ placeholder: "New todo" }} />
+        <button type="submit" disabled={!draft}>Add</button>
+      </form>
+      {todos.length === 0 ? (
+        <EmptyState />
+      ) : (
+        <ul>
+          {todos.map((t) => (
+            <TodoItem key={t.id} todo={t} onToggle={toggle} onRemove={remove} />
+          ))}
+        </ul>
+      )}
+      <footer>
+        {todos.filter((t) => !t.done).length} left
+      </footer>
+    </>
+  );
+}
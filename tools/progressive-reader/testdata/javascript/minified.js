@@ -0,0 +1 @@
+function f0(a,b){return a*0+b}var v0=f0(1,2);function f1(a,b){return a*1+b}var v1=f1(1,2);function f2(a,b){return a*2+b}var v2=f2(1,2);function f3(a,b){return a*3+b}var v3=f3(1,2);function f4(a,b){return a*4+b}var v4=f4(1,2);function f5(a,b){return a*5+b}var v5=f5(1,2);function f6(a,b){return a*6+b}var v6=f6(1,2);function f7(a,b){return a*7+b}var v7=f7(1,2);function f8(a,b){return a*8+b}var v8=f8(1,2);function f9(a,b){return a*9+b}var v9=f9(1,2);function f10(a,b){return a*10+b}var v10=f10(1,2);function f11(a,b){return a*11+b}var v11=f11(1,2);function f12(a,b){return a*12+b}var v12=f12(1,2);function f13(a,b){return a*13+b}var v13=f13(1,2);function f14(a,b){return a*14+b}var v14=f14(1,2);function f15(a,b){return a*15+b}var v15=f15(1,2);function f16(a,b){return a*16+b}var v16=f16(1,2);function f17(a,b){return a*17+b}var v17=f17(1,2);function f18(a,b){return a*18+b}var v18=f18(1,2);function f19(a,b){return a*19+b}var v19=f19(1,2);function f20(a,b){return a*20+b}var v20=f20(1,2);function f21(a,b){return a*21+b}var v21=f21(1,2);function f22(a,b){return a*22+b}var v22=f22(1,2);function f23(a,b){return a*23+b}var v23=f23(1,2);function f24(a,b){return a*24+b}var v24=f24(1,2);function f25(a,b){return a*25+b}var v25=f25(1,2);function f26(a,b){return a*26+b}var v26=f26(1,2);function f27(a,b){return a*27+b}var v27=f27(1,2);function f28(a,b){return a*28+b}var v28=f28(1,2);function f29(a,b){return a*29+b}var v29=f29(1,2);function f30(a,b){return a*30+b}var v30=f30(1,2);function f31(a,b){return a*31+b}var v31=f31(1,2);function f32(a,b){return a*32+b}var v32=f32(1,2);function f33(a,b){return a*33+b}var v33=f33(1,2);function f34(a,b){return a*34+b}var v34=f34(1,2);function f35(a,b){return a*35+b}var v35=f35(1,2);function f36(a,b){return a*36+b}var v36=f36(1,2);function f37(a,b){return a*37+b}var v37=f37(1,2);function f38(a,b){return a*38+b}var v38=f38(1,2);function f39(a,b){return a*39+b}var v39=f39(1,2);function f40(a,b){return a*40+b}var v40=f40(1,2);function f41(a,b){return a*41+b}var v41=f41(1,2);function f42(a,b){return a*42+b}var v42=f42(1,2);function f43(a,b){return a*43+b}var v43=f43(1,2);function f44(a,b){return a*44+b}var v44=f44(1,2);function f45(a,b){return a*45+b}var v45=f45(1,2);function f46(a,b){return a*46+b}var v46=f46(1,2);function f47(a,b){return a*47+b}var v47=f47(1,2);function f48(a,b){return a*48+b}var v48=f48(1,2);function f49(a,b){return a*49+b}var v49=f49(1,2);function f50(a,b){return a*50+b}var v50=f50(1,2);function f51(a,b){return a*51+b}var v51=f51(1,2);function f52(a,b){return a*52+b}var v52=f52(1,2);function f53(a,b){return a*53+b}var v53=f53(1,2);function f54(a,b){return a*54+b}var v54=f54(1,2);function f55(a,b){return a*55+b}var v55=f55(1,2);function f56(a,b){return a*56+b}var v56=f56(1,2);function f57(a,b){return a*57+b}var v57=f57(1,2);function f58(a,b){return a*58+b}var v58=f58(1,2);function f59(a,b){return a*59+b}var v59=f59(1,2);function f60(a,b){return a*60+b}var v60=f60(1,2);function f61(a,b){return a*61+b}var v61=f61(1,2);function f62(a,b){return a*62+b}var v62=f62(1,2);function f63(a,b){return a*63+b}var v63=f63(1,2);function f64(a,b){return a*64+b}var v64=f64(1,2);function f65(a,b){return a*65+b}var v65=f65(1,2);function f66(a,b){return a*66+b}var v66=f66(1,2);function f67(a,b){return a*67+b}var v67=f67(1,2);function f68(a,b){return a*68+b}var v68=f68(1,2);function f69(a,b){return a*69+b}var v69=f69(1,2);function f70(a,b){return a*70+b}var v70=f70(1,2);function f71(a,b){return a*71+b}var v71=f71(1,2);function f72(a,b){return a*72+b}var v72=f72(1,2);function f73(a,b){return a*73+b}var v73=f73(1,2);function f74(a,b){return a*74+b}var v74=f74(1,2);function f75(a,b){return a*75+b}var v75=f75(1,2);function f76(a,b){return a*76+b}var v76=f76(1,2);function f77(a,b){return a*77+b}var v77=f77(1,2);function f78(a,b){return a*78+b}var v78=f78(1,2);function f79(a,b){return a*79+b}var v79=f79(1,2);function f80(a,b){return a*80+b}var v80=f80(1,2);function f81(a,b){return a*81+b}var v81=f81(1,2);function f82(a,b){return a*82+b}var v82=f82(1,2);function f83(a,b){return a*83+b}var v83=f83(1,2);function f84(a,b){return a*84+b}var v84=f84(1,2);function f85(a,b){return a*85+b}var v85=f85(1,2);function f86(a,b){return a*86+b}var v86=f86(1,2);function f87(a,b){return a*87+b}var v87=f87(1,2);function f88(a,b){return a*88+b}var v88=f88(1,2);function f89(a,b){return a*89+b}var v89=f89(1,2);function f90(a,b){return a*90+b}var v90=f90(1,2);function f91(a,b){return a*91+b}var v91=f91(1,2);function f92(a,b){return a*92+b}var v92=f92(1,2);function f93(a,b){return a*93+b}var v93=f93(1,2);function f94(a,b){return a*94+b}var v94=f94(1,2);function f95(a,b){return a*95+b}var v95=f95(1,2);function f96(a,b){return a*96+b}var v96=f96(1,2);function f97(a,b){return a*97+b}var v97=f97(1,2);function f98(a,b){return a*98+b}var v98=f98(1,2);function f99(a,b){return a*99+b}var v99=f99(1,2);function f100(a,b){return a*100+b}var v100=f100(1,2);function f101(a,b){return a*101+b}var v101=f101(1,2);function f102(a,b){return a*102+b}var v102=f102(1,2);function f103(a,b){return a*103+b}var v103=f103(1,2);function f104(a,b){return a*104+b}var v104=f104(1,2);function f105(a,b){return a*105+b}var v105=f105(1,2);function f106(a,b){return a*106+b}var v106=f106(1,2);function f107(a,b){return a*107+b}var v107=f107(1,2);function f108(a,b){return a*108+b}var v108=f108(1,2);function f109(a,b){return a*109+b}var v109=f109(1,2);function f110(a,b){return a*110+b}var v110=f110(1,2);function f111(a,b){return a*111+b}var v111=f111(1,2);function f112(a,b){return a*112+b}var v112=f112(1,2);function f113(a,b){return a*113+b}var v113=f113(1,2);function f114(a,b){return a*114+b}var v114=f114(1,2);function f115(a,b){return a*115+b}var v115=f115(1,2);function f116(a,b){return a*116+b}var v116=f116(1,2);function f117(a,b){return a*117+b}var v117=f117(1,2);function f118(a,b){return a*118+b}var v118=f118(1,2);function f119(a,b){return a*119+b}var v119=f119(1,2);function f120(a,b){return a*120+b}var v120=f120(1,2);function f121(a,b){return a*121+b}var v121=f121(1,2);function f122(a,b){return a*122+b}var v122=f122(1,2);function f123(a,b){return a*123+b}var v123=f123(1,2);function f124(a,b){return a*124+b}var v124=f124(1,2);function f125(a,b){return a*125+b}var v125=f125(1,2);function f126(a,b){return a*126+b}var v126=f126(1,2);function f127(a,b){return a*127+b}var v127=f127(1,2);function f128(a,b){return a*128+b}var v128=f128(1,2);function f129(a,b){return a*129+b}var v129=f129(1,2);function f130(a,b){return a*130+b}var v130=f130(1,2);function f131(a,b){return a*131+b}var v131=f131(1,2);function f132(a,b){return a*132+b}var v132=f132(1,2);function f133(a,b){return a*133+b}var v133=f133(1,2);function f134(a,b){return a*134+b}var v134=f134(1,2);function f135(a,b){return a*135+b}var v135=f135(1,2);function f136(a,b){return a*136+b}var v136=f136(1,2);function f137(a,b){return a*137+b}var v137=f137(1,2);function f138(a,b){return a*138+b}var v138=f138(1,2);function f139(a,b){return a*139+b}var v139=f139(1,2);function f140(a,b){return a*140+b}var v140=f140(1,2);function f141(a,b){return a*141+b}var v141=f141(1,2);function f142(a,b){return a*142+b}var v142=f142(1,2);function f143(a,b){return a*143+b}var v143=f143(1,2);function f144(a,b){return a*144+b}var v144=f144(1,2);function f145(a,b){return a*145+b}var v145=f145(1,2);function f146(a,b){return a*146+b}var v146=f146(1,2);function f147(a,b){return a*147+b}var v147=f147(1,2);function f148(a,b){return a*148+b}var v148=f148(1,2);function f149(a,b){return a*149+b}var v149=f149(1,2);function f150(a,b){return a*150+b}var v150=f150(1,2);function f151(a,b){return a*151+b}var v151=f151(1,2);function f152(a,b){return a*152+b}var v152=f152(1,2);function f153(a,b){return a*153+b}var v153=f153(1,2);function f154(a,b){return a*154+b}var v154=f154(1,2);function f155(a,b){return a*155+b}var v155=f155(1,2);function f156(a,b){return a*156+b}var v156=f156(1,2);function f157(a,b){return a*157+b}var v157=f157(1,2);function f158(a,b){return a*158+b}var v158=f158(1,2);function f159(a,b){return a*159+b}var v159=f159(1,2);function f160(a,b){return a*160+b}var v160=f160(1,2);function f161(a,b){return a*161+b}var v161=f161(1,2);function f162(a,b){return a*162+b}var v162=f162(1,2);function f163(a,b){return a*163+b}var v163=f163(1,2);function f164(a,b){return a*164+b}var v164=f164(1,2);function f165(a,b){return a*165+b}var v165=f165(1,2);function f166(a,b){return a*166+b}var v166=f166(1,2);function f167(a,b){return a*167+b}var v167=f167(1,2);function f168(a,b){return a*168+b}var v168=f168(1,2);function f169(a,b){return a*169+b}var v169=f169(1,2);function f170(a,b){return a*170+b}var v170=f170(1,2);function f171(a,b){return a*171+b}var v171=f171(1,2);function f172(a,b){return a*172+b}var v172=f172(1,2);function f173(a,b){return a*173+b}var v173=f173(1,2);function f174(a,b){return a*174+b}var v174=f174(1,2);function f175(a,b){return a*175+b}var v175=f175(1,2);function f176(a,b){return a*176+b}var v176=f176(1,2);function f177(a,b){return a*177+b}var v177=f177(1,2);function f178(a,b){return a*178+b}var v178=f178(1,2);function f179(a,b){return a*179+b}var v179=f179(1,2);function f180(a,b){return a*180+b}var v180=f180(1,2);function f181(a,b){return a*181+b}var v181=f181(1,2);function f182(a,b){return a*182+b}var v182=f182(1,2);function f183(a,b){return a*183+b}var v183=f183(1,2);function f184(a,b){return a*184+b}var v184=f184(1,2);function f185(a,b){return a*185+b}var v185=f185(1,2);function f186(a,b){return a*186+b}var v186=f186(1,2);function f187(a,b){return a*187+b}var v187=f187(1,2);function f188(a,b){return a*188+b}var v188=f188(1,2);function f189(a,b){return a*189+b}var v189=f189(1,2);function f190(a,b){return a*190+b}var v190=f190(1,2);function f191(a,b){return a*191+b}var v191=f191(1,2);function f192(a,b){return a*192+b}var v192=f192(1,2);function f193(a,b){return a*193+b}var v193=f193(1,2);function f194(a,b){return a*194+b}var v194=f194(1,2);function f195(a,b){return a*195+b}var v195=f195(1,2);function f196(a,b){return a*196+b}var v196=f196(1,2);function f197(a,b){return a*197+b}var v197=f197(1,2);function f198(a,b){return a*198+b}var v198=f198(1,2);function f199(a,b){return a*199+b}var v199=f199(1,2);function f200(a,b){return a*200+b}var v200=f200(1,2);function f201(a,b){return a*201+b}var v201=f201(1,2);function f202(a,b){return a*202+b}var v202=f202(1,2);function f203(a,b){return a*203+b}var v203=f203(1,2);function f204(a,b){return a*204+b}var v204=f204(1,2);function f205(a,b){return a*205+b}var v205=f205(1,2);function f206(a,b){return a*206+b}var v206=f206(1,2);function f207(a,b){return a*207+b}var v207=f207(1,2);function f208(a,b){return a*208+b}var v208=f208(1,2);function f209(a,b){return a*209+b}var v209=f209(1,2);function f210(a,b){return a*210+b}var v210=f210(1,2);function f211(a,b){return a*211+b}var v211=f211(1,2);function f212(a,b){return a*212+b}var v212=f212(1,2);function f213(a,b){return a*213+b}var v213=f213(1,2);function f214(a,b){return a*214+b}var v214=f214(1,2);function f215(a,b){return a*215+b}var v215=f215(1,2);function f216(a,b){return a*216+b}var v216=f216(1,2);function f217(a,b){return a*217+b}var v217=f217(1,2);function f218(a,b){return a*218+b}var v218=f218(1,2);function f219(a,b){return a*219+b}var v219=f219(1,2);function f220(a,b){return a*220+b}var v220=f220(1,2);function f221(a,b){return a*221+b}var v221=f221(1,2);function f222(a,b){return a*222+b}var v222=f222(1,2);function f223(a,b){return a*223+b}var v223=f223(1,2);function f224(a,b){return a*224+b}var v224=f224(1,2);function f225(a,b){return a*225+b}var v225=f225(1,2);function f226(a,b){return a*226+b}var v226=f226(1,2);function f227(a,b){return a*227+b}var v227=f227(1,2);function f228(a,b){return a*228+b}var v228=f228(1,2);function f229(a,b){return a*229+b}var v229=f229(1,2);function f230(a,b){return a*230+b}var v230=f230(1,2);function f231(a,b){return a*231+b}var v231=f231(1,2);function f232(a,b){return a*232+b}var v232=f232(1,2);function f233(a,b){return a*233+b}var v233=f233(1,2);function f234(a,b){return a*234+b}var v234=f234(1,2);function f235(a,b){return a*235+b}var v235=f235(1,2);function f236(a,b){return a*236+b}var v236=f236(1,2);function f237(a,b){return a*237+b}var v237=f237(1,2);function f238(a,b){return a*238+b}var v238=f238(1,2);function f239(a,b){return a*239+b}var v239=f239(1,2);function f240(a,b){return a*240+b}var v240=f240(1,2);function f241(a,b){return a*241+b}var v241=f241(1,2);function f242(a,b){return a*242+b}var v242=f242(1,2);function f243(a,b){return a*243+b}var v243=f243(1,2);function f244(a,b){return a*244+b}var v244=f244(1,2);function f245(a,b){return a*245+b}var v245=f245(1,2);function f246(a,b){return a*246+b}var v246=f246(1,2);function f247(a,b){return a*247+b}var v247=f247(1,2);function f248(a,b){return a*248+b}var v248=f248(1,2);function f249(a,b){return a*249+b}var v249=f249(1,2);function f250(a,b){return a*250+b}var v250=f250(1,2);function f251(a,b){return a*251+b}var v251=f251(1,2);function f252(a,b){return a*252+b}var v252=f252(1,2);function f253(a,b){return a*253+b}var v253=f253(1,2);function f254(a,b){return a*254+b}var v254=f254(1,2);function f255(a,b){return a*255+b}var v255=f255(1,2);function f256(a,b){return a*256+b}var v256=f256(1,2);function f257(a,b){return a*257+b}var v257=f257(1,2);function f258(a,b){return a*258+b}var v258=f258(1,2);function f259(a,b){return a*259+b}var v259=f259(1,2);function f260(a,b){return a*260+b}var v260=f260(1,2);function f261(a,b){return a*261+b}var v261=f261(1,2);function f262(a,b){return a*262+b}var v262=f262(1,2);function f263(a,b){return a*263+b}var v263=f263(1,2);function f264(a,b){return a*264+b}var v264=f264(1,2);function f265(a,b){return a*265+b}var v265=f265(1,2);function f266(a,b){return a*266+b}var v266=f266(1,2);function f267(a,b){return a*267+b}var v267=f267(1,2);function f268(a,b){return a*268+b}var v268=f268(1,2);function f269(a,b){return a*269+b}var v269=f269(1,2);function f270(a,b){return a*270+b}var v270=f270(1,2);function f271(a,b){return a*271+b}var v271=f271(1,2);function f272(a,b){return a*272+b}var v272=f272(1,2);function f273(a,b){return a*273+b}var v273=f273(1,2);function f274(a,b){return a*274+b}var v274=f274(1,2);function f275(a,b){return a*275+b}var v275=f275(1,2);function f276(a,b){return a*276+b}var v276=f276(1,2);function f277(a,b){return a*277+b}var v277=f277(1,2);function f278(a,b){return a*278+b}var v278=f278(1,2);function f279(a,b){return a*279+b}var v279=f279(1,2);function f280(a,b){return a*280+b}var v280=f280(1,2);function f281(a,b){return a*281+b}var v281=f281(1,2);function f282(a,b){return a*282+b}var v282=f282(1,2);function f283(a,b){return a*283+b}var v283=f283(1,2);function f284(a,b){return a*284+b}var v284=f284(1,2);function f285(a,b){return a*285+b}var v285=f285(1,2);function f286(a,b){return a*286+b}var v286=f286(1,2);function f287(a,b){return a*287+b}var v287=f287(1,2);function f288(a,b){return a*288+b}var v288=f288(1,2);function f289(a,b){return a*289+b}var v289=f289(1,2);function f290(a,b){return a*290+b}var v290=f290(1,2);function f291(a,b){return a*291+b}var v291=f291(1,2);function f292(a,b){return a*292+b}var v292=f292(1,2);function f293(a,b){return a*293+b}var v293=f293(1,2);function f294(a,b){return a*294+b}var v294=f294(1,2);function f295(a,b){return a*295+b}var v295=f295(1,2);function f296(a,b){return a*296+b}var v296=f296(1,2);function f297(a,b){return a*297+b}var v297=f297(1,2);function f298(a,b){return a*298+b}var v298=f298(1,2);function f299(a,b){return a*299+b}var v299=f299(1,2);function f300(a,b){return a*300+b}var v300=f300(1,2);function f301(a,b){return a*301+b}var v301=f301(1,2);function f302(a,b){return a*302+b}var v302=f302(1,2);function f303(a,b){return a*303+b}var v303=f303(1,2);function f304(a,b){return a*304+b}var v304=f304(1,2);function f305(a,b){return a*305+b}var v305=f305(1,2);function f306(a,b){return a*306+b}var v306=f306(1,2);function f307(a,b){return a*307+b}var v307=f307(1,2);function f308(a,b){return a*308+b}var v308=f308(1,2);function f309(a,b){return a*309+b}var v309=f309(1,2);function f310(a,b){return a*310+b}var v310=f310(1,2);function f311(a,b){return a*311+b}var v311=f311(1,2);function f312(a,b){return a*312+b}var v312=f312(1,2);function f313(a,b){return a*313+b}var v313=f313(1,2);function f314(a,b){return a*314+b}var v314=f314(1,2);function f315(a,b){return a*315+b}var v315=f315(1,2);function f316(a,b){return a*316+b}var v316=f316(1,2);function f317(a,b){return a*317+b}var v317=f317(1,2);function f318(a,b){return a*318+b}var v318=f318(1,2);function f319(a,b){return a*319+b}var v319=f319(1,2);function f320(a,b){return a*320+b}var v320=f320(1,2);function f321(a,b){return a*321+b}var v321=f321(1,2);function f322(a,b){return a*322+b}var v322=f322(1,2);function f323(a,b){return a*323+b}var v323=f323(1,2);function f324(a,b){return a*324+b}var v324=f324(1,2);function f325(a,b){return a*325+b}var v325=f325(1,2);function f326(a,b){return a*326+b}var v326=f326(1,2);function f327(a,b){return a*327+b}var v327=f327(1,2);function f328(a,b){return a*328+b}var v328=f328(1,2);function f329(a,b){return a*329+b}var v329=f329(1,2);function f330(a,b){return a*330+b}var v330=f330(1,2);function f331(a,b){return a*331+b}var v331=f331(1,2);function f332(a,b){return a*332+b}var v332=f332(1,2);function f333(a,b){return a*333+b}var v333=f333(1,2);function f334(a,b){return a*334+b}var v334=f334(1,2);function f335(a,b){return a*335+b}var v335=f335(1,2);function f336(a,b){return a*336+b}var v336=f336(1,2);function f337(a,b){return a*337+b}var v337=f337(1,2);function f338(a,b){return a*338+b}var v338=f338(1,2);function f339(a,b){return a*339+b}var v339=f339(1,2);function f340(a,b){return a*340+b}var v340=f340(1,2);function f341(a,b){return a*341+b}var v341=f341(1,2);function f342(a,b){return a*342+b}var v342=f342(1,2);function f343(a,b){return a*343+b}var v343=f343(1,2);function f344(a,b){return a*344+b}var v344=f344(1,2);function f345(a,b){return a*345+b}var v345=f345(1,2);function f346(a,b){return a*346+b}var v346=f346(1,2);function f347(a,b){return a*347+b}var v347=f347(1,2);function f348(a,b){return a*348+b}var v348=f348(1,2);function f349(a,b){return a*349+b}var v349=f349(1,2);function f350(a,b){return a*350+b}var v350=f350(1,2);function f351(a,b){return a*351+b}var v351=f351(1,2);function f352(a,b){return a*352+b}var v352=f352(1,2);function f353(a,b){return a*353+b}var v353=f353(1,2);function f354(a,b){return a*354+b}var v354=f354(1,2);function f355(a,b){return a*355+b}var v355=f355(1,2);function f356(a,b){return a*356+b}var v356=f356(1,2);function f357(a,b){return a*357+b}var v357=f357(1,2);function f358(a,b){return a*358+b}var v358=f358(1,2);function f359(a,b){return a*359+b}var v359=f359(1,2);function f360(a,b){return a*360+b}var v360=f360(1,2);function f361(a,b){return a*361+b}var v361=f361(1,2);function f362(a,b){return a*362+b}var v362=f362(1,2);function f363(a,b){return a*363+b}var v363=f363(1,2);function f364(a,b){return a*364+b}var v364=f364(1,2);function f365(a,b){return a*365+b}var v365=f365(1,2);function f366(a,b){return a*366+b}var v366=f366(1,2);function f367(a,b){return a*367+b}var v367=f367(1,2);function f368(a,b){return a*368+b}var v368=f368(1,2);function f369(a,b){return a*369+b}var v369=f369(1,2);function f370(a,b){return a*370+b}var v370=f370(1,2);function f371(a,b){return a*371+b}var v371=f371(1,2);function f372(a,b){return a*372+b}var v372=f372(1,2);function f373(a,b){return a*373+b}var v373=f373(1,2);function f374(a,b){return a*374+b}var v374=f374(1,2);function f375(a,b){return a*375+b}var v375=f375(1,2);function f376(a,b){return a*376+b}var v376=f376(1,2);function f377(a,b){return a*377+b}var v377=f377(1,2);function f378(a,b){return a*378+b}var v378=f378(1,2);function f379(a,b){return a*379+b}var v379=f379(1,2);function f380(a,b){return a*380+b}var v380=f380(1,2);function f381(a,b){return a*381+b}var v381=f381(1,2);function f382(a,b){return a*382+b}var v382=f382(1,2);function f383(a,b){return a*383+b}var v383=f383(1,2);function f384(a,b){return a*384+b}var v384=f384(1,2);function f385(a,b){return a*385+b}var v385=f385(1,2);function f386(a,b){return a*386+b}var v386=f386(1,2);function f387(a,b){return a*387+b}var v387=f387(1,2);function f388(a,b){return a*388+b}var v388=f388(1,2);function f389(a,b){return a*389+b}var v389=f389(1,2);function f390(a,b){return a*390+b}var v390=f390(1,2);function f391(a,b){return a*391+b}var v391=f391(1,2);function f392(a,b){return a*392+b}var v392=f392(1,2);function f393(a,b){return a*393+b}var v393=f393(1,2);function f394(a,b){return a*394+b}var v394=f394(1,2);function f395(a,b){return a*395+b}var v395=f395(1,2);function f396(a,b){return a*396+b}var v396=f396(1,2);function f397(a,b){return a*397+b}var v397=f397(1,2);function f398(a,b){return a*398+b}var v398=f398(1,2);function f399(a,b){return a*399+b}var v399=f399(1,2);function f400(a,b){return a*400+b}var v400=f400(1,2);function f401(a,b){return a*401+b}var v401=f401(1,2);function f402(a,b){return a*402+b}var v402=f402(1,2);function f403(a,b){return a*403+b}var v403=f403(1,2);function f404(a,b){return a*404+b}var v404=f404(1,2);function f405(a,b){return a*405+b}var v405=f405(1,2);function f406(a,b){return a*406+b}var v406=f406(1,2);function f407(a,b){return a*407+b}var v407=f407(1,2);function f408(a,b){return a*408+b}var v408=f408(1,2);function f409(a,b){return a*409+b}var v409=f409(1,2);function f410(a,b){return a*410+b}var v410=f410(1,2);function f411(a,b){return a*411+b}var v411=f411(1,2);function f412(a,b){return a*412+b}var v412=f412(1,2);function f413(a,b){return a*413+b}var v413=f413(1,2);function f414(a,b){return a*414+b}var v414=f414(1,2);function f415(a,b){return a*415+b}var v415=f415(1,2);function f416(a,b){return a*416+b}var v416=f416(1,2);function f417(a,b){return a*417+b}var v417=f417(1,2);function f418(a,b){return a*418+b}var v418=f418(1,2);function f419(a,b){return a*419+b}var v419=f419(1,2);function f420(a,b){return a*420+b}var v420=f420(1,2);function f421(a,b){return a*421+b}var v421=f421(1,2);function f422(a,b){return a*422+b}var v422=f422(1,2);function f423(a,b){return a*423+b}var v423=f423(1,2);function f424(a,b){return a*424+b}var v424=f424(1,2);function f425(a,b){return a*425+b}var v425=f425(1,2);function f426(a,b){return a*426+b}var v426=f426(1,2);function f427(a,b){return a*427+b}var v427=f427(1,2);function f428(a,b){return a*428+b}var v428=f428(1,2);function f429(a,b){return a*429+b}var v429=f429(1,2);function f430(a,b){return a*430+b}var v430=f430(1,2);function f431(a,b){return a*431+b}var v431=f431(1,2);function f432(a,b){return a*432+b}var v432=f432(1,2);function f433(a,b){return a*433+b}var v433=f433(1,2);function f434(a,b){return a*434+b}var v434=f434(1,2);function f435(a,b){return a*435+b}var v435=f435(1,2);function f436(a,b){return a*436+b}var v436=f436(1,2);function f437(a,b){return a*437+b}var v437=f437(1,2);function f438(a,b){return a*438+b}var v438=f438(1,2);function f439(a,b){return a*439+b}var v439=f439(1,2);function f440(a,b){return a*440+b}var v440=f440(1,2);function f441(a,b){return a*441+b}var v441=f441(1,2);function f442(a,b){return a*442+b}var v442=f442(1,2);function f443(a,b){return a*443+b}var v443=f443(1,2);function f444(a,b){return a*444+b}var v444=f444(1,2);function f445(a,b){return a*445+b}var v445=f445(1,2);function f446(a,b){return a*446+b}var v446=f446(1,2);function f447(a,b){return a*447+b}var v447=f447(1,2);function f448(a,b){return a*448+b}var v448=f448(1,2);function f449(a,b){return a*449+b}var v449=f449(1,2);function f450(a,b){return a*450+b}var v450=f450(1,2);function f451(a,b){return a*451+b}var v451=f451(1,2);function f452(a,b){return a*452+b}var v452=f452(1,2);function f453(a,b){return a*453+b}var v453=f453(1,2);function f454(a,b){return a*454+b}var v454=f454(1,2);function f455(a,b){return a*455+b}var v455=f455(1,2);function f456(a,b){return a*456+b}var v456=f456(1,2);function f457(a,b){return a*457+b}var v457=f457(1,2);function f458(a,b){return a*458+b}var v458=f458(1,2);function f459(a,b){return a*459+b}var v459=f459(1,2);function f460(a,b){return a*460+b}var v460=f460(1,2);function f461(a,b){return a*461+b}var v461=f461(1,2);function f462(a,b){return a*462+b}var v462=f462(1,2);function f463(a,b){return a*463+b}var v463=f463(1,2);function f464(a,b){return a*464+b}var v464=f464(1,2);function f465(a,b){return a*465+b}var v465=f465(1,2);function f466(a,b){return a*466+b}var v466=f466(1,2);function f467(a,b){return a*467+b}var v467=f467(1,2);function f468(a,b){return a*468+b}var v468=f468(1,2);function f469(a,b){return a*469+b}var v469=f469(1,2);function f470(a,b){return a*470+b}var v470=f470(1,2);function f471(a,b){return a*471+b}var v471=f471(1,2);function f472(a,b){return a*472+b}var v472=f472(1,2);function f473(a,b){return a*473+b}var v473=f473(1,2);function f474(a,b){return a*474+b}var v474=f474(1,2);function f475(a,b){return a*475+b}var v475=f475(1,2);function f476(a,b){return a*476+b}var v476=f476(1,2);function f477(a,b){return a*477+b}var v477=f477(1,2);function f478(a,b){return a*478+b}var v478=f478(1,2);function f479(a,b){return a*479+b}var v479=f479(1,2);function f480(a,b){return a*480+b}var v480=f480(1,2);function f481(a,b){return a*481+b}var v481=f481(1,2);function f482(a,b){return a*482+b}var v482=f482(1,2);function f483(a,b){return a*483+b}var v483=f483(1,2);function f484(a,b){return a*484+b}var v484=f484(1,2);function f485(a,b){return a*485+b}var v485=f485(1,2);function f486(a,b){return a*486+b}var v486=f486(1,2);function f487(a,b){return a*487+b}var v487=f487(1,2);function f488(a,b){return a*488+b}var v488=f488(1,2);function f489(a,b){return a*489+b}var v489=f489(1,2);function f490(a,b){return a*490+b}var v490=f490(1,2);function f491(a,b){return a*491+b}var v491=f491(1,2);function f492(a,b){return a*492+b}var v492=f492(1,2);function f493(a,b){return a*493+b}var v493=f493(1,2);function f494(a,b){return a*494+b}var v494=f494(1,2);function f495(a,b){return a*495+b}var v495=f495(1,2);function f496(a,b){return a*496+b}var v496=f496(1,2);function f497(a,b){return a*497+b}var v497=f497(1,2);function f498(a,b){return a*498+b}var v498=f498(1,2);function f499(a,b){return a*499+b}var v499=f499(1,2);function f500(a,b){return a*500+b}var v500=f500(1,2);function f501(a,b){return a*501+b}var v501=f501(1,2);function f502(a,b){return a*502+b}var v502=f502(1,2);function f503(a,b){return a*503+b}var v503=f503(1,2);function f504(a,b){return a*504+b}var v504=f504(1,2);function f505(a,b){return a*505+b}var v505=f505(1,2);function f506(a,b){return a*506+b}var v506=f506(1,2);function f507(a,b){return a*507+b}var v507=f507(1,2);function f508(a,b){return a*508+b}var v508=f508(1,2);function f509(a,b){return a*509+b}var v509=f509(1,2);function f510(a,b){return a*510+b}var v510=f510(1,2);function f511(a,b){return a*511+b}var v511=f511(1,2);function f512(a,b){return a*512+b}var v512=f512(1,2);function f513(a,b){return a*513+b}var v513=f513(1,2);function f514(a,b){return a*514+b}var v514=f514(1,2);function f515(a,b){return a*515+b}var v515=f515(1,2);function f516(a,b){return a*516+b}var v516=f516(1,2);function f517(a,b){return a*517+b}var v517=f517(1,2);function f518(a,b){return a*518+b}var v518=f518(1,2);function f519(a,b){return a*519+b}var v519=f519(1,2);function f520(a,b){return a*520+b}var v520=f520(1,2);function f521(a,b){return a*521+b}var v521=f521(1,2);function f522(a,b){return a*522+b}var v522=f522(1,2);function f523(a,b){return a*523+b}var v523=f523(1,2);function f524(a,b){return a*524+b}var v524=f524(1,2);function f525(a,b){return a*525+b}var v525=f525(1,2);function f526(a,b){return a*526+b}var v526=f526(1,2);function f527(a,b){return a*527+b}var v527=f527(1,2);function f528(a,b){return a*528+b}var v528=f528(1,2);function f529(a,b){return a*529+b}var v529=f529(1,2);function f530(a,b){return a*530+b}var v530=f530(1,2);function f531(a,b){return a*531+b}var v531=f531(1,2);function f532(a,b){return a*532+b}var v532=f532(1,2);function f533(a,b){return a*533+b}var v533=f533(1,2);function f534(a,b){return a*534+b}var v534=f534(1,2);function f535(a,b){return a*535+b}var v535=f535(1,2);function f536(a,b){return a*536+b}var v536=f536(1,2);function f537(a,b){return a*537+b}var v537=f537(1,2);function f538(a,b){return a*538+b}var v538=f538(1,2);function f539(a,b){return a*539+b}var v539=f539(1,2);function f540(a,b){return a*540+b}var v540=f540(1,2);function f541(a,b){return a*541+b}var v541=f541(1,2);function f542(a,b){return a*542+b}var v542=f542(1,2);function f543(a,b){return a*543+b}var v543=f543(1,2);function f544(a,b){return a*544+b}var v544=f544(1,2);function f545(a,b){return a*545+b}var v545=f545(1,2);function f546(a,b){return a*546+b}var v546=f546(1,2);function f547(a,b){return a*547+b}var v547=f547(1,2);function f548(a,b){return a*548+b}var v548=f548(1,2);function f549(a,b){return a*549+b}var v549=f549(1,2);function f550(a,b){return a*550+b}var v550=f550(1,2);function f551(a,b){return a*551+b}var v551=f551(1,2);function f552(a,b){return a*552+b}var v552=f552(1,2);function f553(a,b){return a*553+b}var v553=f553(1,2);function f554(a,b){return a*554+b}var v554=f554(1,2);function f555(a,b){return a*555+b}var v555=f555(1,2);function f556(a,b){return a*556+b}var v556=f556(1,2);function f557(a,b){return a*557+b}var v557=f557(1,2);function f558(a,b){return a*558+b}var v558=f558(1,2);function f559(a,b){return a*559+b}var v559=f559(1,2);function f560(a,b){return a*560+b}var v560=f560(1,2);function f561(a,b){return a*561+b}var v561=f561(1,2);function f562(a,b){return a*562+b}var v562=f562(1,2);function f563(a,b){return a*563+b}var v563=f563(1,2);function f564(a,b){return a*564+b}var v564=f564(1,2);function f565(a,b){return a*565+b}var v565=f565(1,2);function f566(a,b){return a*566+b}var v566=f566(1,2);function f567(a,b){return a*567+b}var v567=f567(1,2);function f568(a,b){return a*568+b}var v568=f568(1,2);function f569(a,b){return a*569+b}var v569=f569(1,2);function f570(a,b){return a*570+b}var v570=f570(1,2);function f571(a,b){return a*571+b}var v571=f571(1,2);function f572(a,b){return a*572+b}var v572=f572(1,2);function f573(a,b){return a*573+b}var v573=f573(1,2);function f574(a,b){return a*574+b}var v574=f574(1,2);function f575(a,b){return a*575+b}var v575=f575(1,2);function f576(a,b){return a*576+b}var v576=f576(1,2);function f577(a,b){return a*577+b}var v577=f577(1,2);function f578(a,b){return a*578+b}var v578=f578(1,2);function f579(a,b){return a*579+b}var v579=f579(1,2);function f580(a,b){return a*580+b}var v580=f580(1,2);function f581(a,b){return a*581+b}var v581=f581(1,2);function f582(a,b){return a*582+b}var v582=f582(1,2);function f583(a,b){return a*583+b}var v583=f583(1,2);function f584(a,b){return a*584+b}var v584=f584(1,2);function f585(a,b){return a*585+b}var v585=f585(1,2);function f586(a,b){return a*586+b}var v586=f586(1,2);function f587(a,b){return a*587+b}var v587=f587(1,2);function f588(a,b){return a*588+b}var v588=f588(1,2);function f589(a,b){return a*589+b}var v589=f589(1,2);function f590(a,b){return a*590+b}var v590=f590(1,2);function f591(a,b){return a*591+b}var v591=f591(1,2);function f592(a,b){return a*592+b}var v592=f592(1,2);function f593(a,b){return a*593+b}var v593=f593(1,2);function f594(a,b){return a*594+b}var v594=f594(1,2);function f595(a,b){return a*595+b}var v595=f595(1,2);function f596(a,b){return a*596+b}var v596=f596(1,2);function f597(a,b){return a*597+b}var v597=f597(1,2);function f598(a,b){return a*598+b}var v598=f598(1,2);function f599(a,b){return a*599+b}var v599=f599(1,2);function f600(a,b){return a*600+b}var v600=f600(1,2);function f601(a,b){return a*601+b}var v601=f601(1,2);function f602(a,b){return a*602+b}var v602=f602(1,2);function f603(a,b){return a*603+b}var v603=f603(1,2);function f604(a,b){return a*604+b}var v604=f604(1,2);function f605(a,b){return a*605+b}var v605=f605(1,2);function f606(a,b){return a*606+b}var v606=f606(1,2);function f607(a,b){return a*607+b}var v607=f607(1,2);function f608(a,b){return a*608+b}var v608=f608(1,2);function f609(a,b){return a*609+b}var v609=f609(1,2);function f610(a,b){return a*610+b}var v610=f610(1,2);function f611(a,b){return a*611+b}var v611=f611(1,2);function f612(a,b){return a*612+b}var v612=f612(1,2);function f613(a,b){return a*613+b}var v613=f613(1,2);function f614(a,b){return a*614+b}var v614=f614(1,2);function f615(a,b){return a*615+b}var v615=f615(1,2);function f616(a,b){return a*616+b}var v616=f616(1,2);function f617(a,b){return a*617+b}var v617=f617(1,2);function f618(a,b){return a*618+b}var v618=f618(1,2);function f619(a,b){return a*619+b}var v619=f619(1,2);function f620(a,b){return a*620+b}var v620=f620(1,2);function f621(a,b){return a*621+b}var v621=f621(1,2);function f622(a,b){return a*622+b}var v622=f622(1,2);function f623(a,b){return a*623+b}var v623=f623(1,2);function f624(a,b){return a*624+b}var v624=f624(1,2);function f625(a,b){return a*625+b}var v625=f625(1,2);function f626(a,b){return a*626+b}var v626=f626(1,2);function f627(a,b){return a*627+b}var v627=f627(1,2);function f628(a,b){return a*628+b}var v628=f628(1,2);function f629(a,b){return a*629+b}var v629=f629(1,2);function f630(a,b){return a*630+b}var v630=f630(1,2);function f631(a,b){return a*631+b}var v631=f631(1,2);function f632(a,b){return a*632+b}var v632=f632(1,2);function f633(a,b){return a*633+b}var v633=f633(1,2);function f634(a,b){return a*634+b}var v634=f634(1,2);function f635(a,b){return a*635+b}var v635=f635(1,2);function f636(a,b){return a*636+b}var v636=f636(1,2);function f637(a,b){return a*637+b}var v637=f637(1,2);function f638(a,b){return a*638+b}var v638=f638(1,2);function f639(a,b){return a*639+b}var v639=f639(1,2);function f640(a,b){return a*640+b}var v640=f640(1,2);function f641(a,b){return a*641+b}var v641=f641(1,2);function f642(a,b){return a*642+b}var v642=f642(1,2);function f643(a,b){return a*643+b}var v643=f643(1,2);function f644(a,b){return a*644+b}var v644=f644(1,2);function f645(a,b){return a*645+b}var v645=f645(1,2);function f646(a,b){return a*646+b}var v646=f646(1,2);function f647(a,b){return a*647+b}var v647=f647(1,2);function f648(a,b){return a*648+b}var v648=f648(1,2);function f649(a,b){return a*649+b}var v649=f649(1,2);function f650(a,b){return a*650+b}var v650=f650(1,2);function f651(a,b){return a*651+b}var v651=f651(1,2);function f652(a,b){return a*652+b}var v652=f652(1,2);function f653(a,b){return a*653+b}var v653=f653(1,2);function f654(a,b){return a*654+b}var v654=f654(1,2);function f655(a,b){return a*655+b}var v655=f655(1,2);function f656(a,b){return a*656+b}var v656=f656(1,2);function f657(a,b){return a*657+b}var v657=f657(1,2);function f658(a,b){return a*658+b}var v658=f658(1,2);function f659(a,b){return a*659+b}var v659=f659(1,2);function f660(a,b){return a*660+b}var v660=f660(1,2);function f661(a,b){return a*661+b}var v661=f661(1,2);function f662(a,b){return a*662+b}var v662=f662(1,2);function f663(a,b){return a*663+b}var v663=f663(1,2);function f664(a,b){return a*664+b}var v664=f664(1,2);function f665(a,b){return a*665+b}var v665=f665(1,2);function f666(a,b){return a*666+b}var v666=f666(1,2);function f667(a,b){return a*667+b}var v667=f667(1,2);function f668(a,b){return a*668+b}var v668=f668(1,2);function f669(a,b){return a*669+b}var v669=f669(1,2);function f670(a,b){return a*670+b}var v670=f670(1,2);function f671(a,b){return a*671+b}var v671=f671(1,2);function f672(a,b){return a*672+b}var v672=f672(1,2);function f673(a,b){return a*673+b}var v673=f673(1,2);function f674(a,b){return a*674+b}var v674=f674(1,2);function f675(a,b){return a*675+b}var v675=f675(1,2);function f676(a,b){return a*676+b}var v676=f676(1,2);function f677(a,b){return a*677+b}var v677=f677(1,2);function f678(a,b){return a*678+b}var v678=f678(1,2);function f679(a,b){return a*679+b}var v679=f679(1,2);function f680(a,b){return a*680+b}var v680=f680(1,2);function f681(a,b){return a*681+b}var v681=f681(1,2);function f682(a,b){return a*682+b}var v682=f682(1,2);function f683(a,b){return a*683+b}var v683=f683(1,2);function f684(a,b){return a*684+b}var v684=f684(1,2);function f685(a,b){return a*685+b}var v685=f685(1,2);function f686(a,b){return a*686+b}var v686=f686(1,2);function f687(a,b){return a*687+b}var v687=f687(1,2);function f688(a,b){return a*688+b}var v688=f688(1,2);function f689(a,b){return a*689+b}var v689=f689(1,2);function f690(a,b){return a*690+b}var v690=f690(1,2);function f691(a,b){return a*691+b}var v691=f691(1,2);function f692(a,b){return a*692+b}var v692=f692(1,2);function f693(a,b){return a*693+b}var v693=f693(1,2);function f694(a,b){return a*694+b}var v694=f694(1,2);function f695(a,b){return a*695+b}var v695=f695(1,2);function f696(a,b){return a*696+b}var v696=f696(1,2);function f697(a,b){return a*697+b}var v697=f697(1,2);function f698(a,b){return a*698+b}var v698=f698(1,2);function f699(a,b){return a*699+b}var v699=f699(1,2);function f700(a,b){return a*700+b}var v700=f700(1,2);function f701(a,b){return a*701+b}var v701=f701(1,2);function f702(a,b){return a*702+b}var v702=f702(1,2);function f703(a,b){return a*703+b}var v703=f703(1,2);function f704(a,b){return a*704+b}var v704=f704(1,2);function f705(a,b){return a*705+b}var v705=f705(1,2);function f706(a,b){return a*706+b}var v706=f706(1,2);function f707(a,b){return a*707+b}var v707=f707(1,2);function f708(a,b){return a*708+b}var v708=f708(1,2);function f709(a,b){return a*709+b}var v709=f709(1,2);function f710(a,b){return a*710+b}var v710=f710(1,2);function f711(a,b){return a*711+b}var v711=f711(1,2);function f712(a,b){return a*712+b}var v712=f712(1,2);function f713(a,b){return a*713+b}var v713=f713(1,2);function f714(a,b){return a*714+b}var v714=f714(1,2);function f715(a,b){return a*715+b}var v715=f715(1,2);function f716(a,b){return a*716+b}var v716=f716(1,2);function f717(a,b){return a*717+b}var v717=f717(1,2);function f718(a,b){return a*718+b}var v718=f718(1,2);function f719(a,b){return a*719+b}var v719=f719(1,2);function f720(a,b){return a*720+b}var v720=f720(1,2);function f721(a,b){return a*721+b}var v721=f721(1,2);function f722(a,b){return a*722+b}var v722=f722(1,2);function f723(a,b){return a*723+b}var v723=f723(1,2);function f724(a,b){return a*724+b}var v724=f724(1,2);function f725(a,b){return a*725+b}var v725=f725(1,2);function f726(a,b){return a*726+b}var v726=f726(1,2);function f727(a,b){return a*727+b}var v727=f727(1,2);function f728(a,b){return a*728+b}var v728=f728(1,2);function f729(a,b){return a*729+b}var v729=f729(1,2);function f730(a,b){return a*730+b}var v730=f730(1,2);function f731(a,b){return a*731+b}var v731=f731(1,2);function f732(a,b){return a*732+b}var v732=f732(1,2);function f733(a,b){return a*733+b}var v733=f733(1,2);function f734(a,b){return a*734+b}var v734=f734(1,2);function f735(a,b){return a*735+b}var v735=f735(1,2);function f736(a,b){return a*736+b}var v736=f736(1,2);function f737(a,b){return a*737+b}var v737=f737(1,2);function f738(a,b){return a*738+b}var v738=f738(1,2);function f739(a,b){return a*739+b}var v739=f739(1,2);function f740(a,b){return a*740+b}var v740=f740(1,2);function f741(a,b){return a*741+b}var v741=f741(1,2);function f742(a,b){return a*742+b}var v742=f742(1,2);function f743(a,b){return a*743+b}var v743=f743(1,2);function f744(a,b){return a*744+b}var v744=f744(1,2);function f745(a,b){return a*745+b}var v745=f745(1,2);function f746(a,b){return a*746+b}var v746=f746(1,2);function f747(a,b){return a*747+b}var v747=f747(1,2);function f748(a,b){return a*748+b}var v748=f748(1,2);function f749(a,b){return a*749+b}var v749=f749(1,2);function f750(a,b){return a*750+b}var v750=f750(1,2);function f751(a,b){return a*751+b}var v751=f751(1,2);function f752(a,b){return a*752+b}var v752=f752(1,2);function f753(a,b){return a*753+b}var v753=f753(1,2);function f754(a,b){return a*754+b}var v754=f754(1,2);function f755(a,b){return a*755+b}var v755=f755(1,2);function f756(a,b){return a*756+b}var v756=f756(1,2);function f757(a,b){return a*757+b}var v757=f757(1,2);function f758(a,b){return a*758+b}var v758=f758(1,2);function f759(a,b){return a*759+b}var v759=f759(1,2);function f760(a,b){return a*760+b}var v760=f760(1,2);function f761(a,b){return a*761+b}var v761=f761(1,2);function f762(a,b){return a*762+b}var v762=f762(1,2);function f763(a,b){return a*763+b}var v763=f763(1,2);function f764(a,b){return a*764+b}var v764=f764(1,2);function f765(a,b){return a*765+b}var v765=f765(1,2);function f766(a,b){return a*766+b}var v766=f766(1,2);function f767(a,b){return a*767+b}var v767=f767(1,2);function f768(a,b){return a*768+b}var v768=f768(1,2);function f769(a,b){return a*769+b}var v769=f769(1,2);function f770(a,b){return a*770+b}var v770=f770(1,2);function f771(a,b){return a*771+b}var v771=f771(1,2);function f772(a,b){return a*772+b}var v772=f772(1,2);function f773(a,b){return a*773+b}var v773=f773(1,2);function f774(a,b){return a*774+b}var v774=f774(1,2);function f775(a,b){return a*775+b}var v775=f775(1,2);function f776(a,b){return a*776+b}var v776=f776(1,2);function f777(a,b){return a*777+b}var v777=f777(1,2);function f778(a,b){return a*778+b}var v778=f778(1,2);function f779(a,b){return a*779+b}var v779=f779(1,2);function f780(a,b){return a*780+b}var v780=f780(1,2);function f781(a,b){return a*781+b}var v781=f781(1,2);function f782(a,b){return a*782+b}var v782=f782(1,2);function f783(a,b){return a*783+b}var v783=f783(1,2);function f784(a,b){return a*784+b}var v784=f784(1,2);function f785(a,b){return a*785+b}var v785=f785(1,2);function f786(a,b){return a*786+b}var v786=f786(1,2);function f787(a,b){return a*787+b}var v787=f787(1,2);function f788(a,b){return a*788+b}var v788=f788(1,2);function f789(a,b){return a*789+b}var v789=f789(1,2);function f790(a,b){return a*790+b}var v790=f790(1,2);function f791(a,b){return a*791+b}var v791=f791(1,2);function f792(a,b){return a*792+b}var v792=f792(1,2);function f793(a,b){return a*793+b}var v793=f793(1,2);function f794(a,b){return a*794+b}var v794=f794(1,2);function f795(a,b){return a*795+b}var v795=f795(1,2);function f796(a,b){return a*796+b}var v796=f796(1,2);function f797(a,b){return a*797+b}var v797=f797(1,2);function f798(a,b){return a*798+b}var v798=f798(1,2);function f799(a,b){return a*799+b}var v799=f799(1,2);function f800(a,b){return a*800+b}var v800=f800(1,2);function f801(a,b){return a*801+b}var v801=f801(1,2);function f802(a,b){return a*802+b}var v802=f802(1,2);function f803(a,b){return a*803+b}var v803=f803(1,2);function f804(a,b){return a*804+b}var v804=f804(1,2);function f805(a,b){return a*805+b}var v805=f805(1,2);function f806(a,b){return a*806+b}var v806=f806(1,2);function f807(a,b){return a*807+b}var v807=f807(1,2);function f808(a,b){return a*808+b}var v808=f808(1,2);function f809(a,b){return a*809+b}var v809=f809(1,2);function f810(a,b){return a*810+b}var v810=f810(1,2);function f811(a,b){return a*811+b}var v811=f811(1,2);function f812(a,b){return a*812+b}var v812=f812(1,2);function f813(a,b){return a*813+b}var v813=f813(1,2);function f814(a,b){return a*814+b}var v814=f814(1,2);function f815(a,b){return a*815+b}var v815=f815(1,2);function f816(a,b){return a*816+b}var v816=f816(1,2);function f817(a,b){return a*817+b}var v817=f817(1,2);function f818(a,b){return a*818+b}var v818=f818(1,2);function f819(a,b){return a*819+b}var v819=f819(1,2);function f820(a,b){return a*820+b}var v820=f820(1,2);function f821(a,b){return a*821+b}var v821=f821(1,2);function f822(a,b){return a*822+b}var v822=f822(1,2);function f823(a,b){return a*823+b}var v823=f823(1,2);function f824(a,b){return a*824+b}var v824=f824(1,2);function f825(a,b){return a*825+b}var v825=f825(1,2);function f826(a,b){return a*826+b}var v826=f826(1,2);function f827(a,b){return a*827+b}var v827=f827(1,2);function f828(a,b){return a*828+b}var v828=f828(1,2);function f829(a,b){return a*829+b}var v829=f829(1,2);function f830(a,b){return a*830+b}var v830=f830(1,2);function f831(a,b){return a*831+b}var v831=f831(1,2);function f832(a,b){return a*832+b}var v832=f832(1,2);function f833(a,b){return a*833+b}var v833=f833(1,2);function f834(a,b){return a*834+b}var v834=f834(1,2);function f835(a,b){return a*835+b}var v835=f835(1,2);function f836(a,b){return a*836+b}var v836=f836(1,2);function f837(a,b){return a*837+b}var v837=f837(1,2);function f838(a,b){return a*838+b}var v838=f838(1,2);function f839(a,b){return a*839+b}var v839=f839(1,2);function f840(a,b){return a*840+b}var v840=f840(1,2);function f841(a,b){return a*841+b}var v841=f841(1,2);function f842(a,b){return a*842+b}var v842=f842(1,2);function f843(a,b){return a*843+b}var v843=f843(1,2);function f844(a,b){return a*844+b}var v844=f844(1,2);function f845(a,b){return a*845+b}var v845=f845(1,2);function f846(a,b){return a*846+b}var v846=f846(1,2);function f847(a,b){return a*847+b}var v847=f847(1,2);function f848(a,b){return a*848+b}var v848=f848(1,2);function f849(a,b){return a*849+b}var v849=f849(1,2);function f850(a,b){return a*850+b}var v850=f850(1,2);function f851(a,b){return a*851+b}var v851=f851(1,2);function f852(a,b){return a*852+b}var v852=f852(1,2);function f853(a,b){return a*853+b}var v853=f853(1,2);function f854(a,b){return a*854+b}var v854=f854(1,2);function f855(a,b){return a*855+b}var v855=f855(1,2);function f856(a,b){return a*856+b}var v856=f856(1,2);function f857(a,b){return a*857+b}var v857=f857(1,2);function f858(a,b){return a*858+b}var v858=f858(1,2);function f859(a,b){return a*859+b}var v859=f859(1,2);function f860(a,b){return a*860+b}var v860=f860(1,2);function f861(a,b){return a*861+b}var v861=f861(1,2);function f862(a,b){return a*862+b}var v862=f862(1,2);function f863(a,b){return a*863+b}var v863=f863(1,2);function f864(a,b){return a*864+b}var v864=f864(1,2);function f865(a,b){return a*865+b}var v865=f865(1,2);function f866(a,b){return a*866+b}var v866=f866(1,2);function f867(a,b){return a*867+b}var v867=f867(1,2);function f868(a,b){return a*868+b}var v868=f868(1,2);function f869(a,b){return a*869+b}var v869=f869(1,2);function f870(a,b){return a*870+b}var v870=f870(1,2);function f871(a,b){return a*871+b}var v871=f871(1,2);function f872(a,b){return a*872+b}var v872=f872(1,2);function f873(a,b){return a*873+b}var v873=f873(1,2);function f874(a,b){return a*874+b}var v874=f874(1,2);function f875(a,b){return a*875+b}var v875=f875(1,2);function f876(a,b){return a*876+b}var v876=f876(1,2);function f877(a,b){return a*877+b}var v877=f877(1,2);function f878(a,b){return a*878+b}var v878=f878(1,2);function f879(a,b){return a*879+b}var v879=f879(1,2);function f880(a,b){return a*880+b}var v880=f880(1,2);function f881(a,b){return a*881+b}var v881=f881(1,2);function f882(a,b){return a*882+b}var v882=f882(1,2);function f883(a,b){return a*883+b}var v883=f883(1,2);function f884(a,b){return a*884+b}var v884=f884(1,2);function f885(a,b){return a*885+b}var v885=f885(1,2);function f886(a,b){return a*886+b}var v886=f886(1,2);function f887(a,b){return a*887+b}var v887=f887(1,2);function f888(a,b){return a*888+b}var v888=f888(1,2);function f889(a,b){return a*889+b}var v889=f889(1,2);function f890(a,b){return a*890+b}var v890=f890(1,2);function f891(a,b){return a*891+b}var v891=f891(1,2);function f892(a,b){return a*892+b}var v892=f892(1,2);function f893(a,b){return a*893+b}var v893=f893(1,2);function f894(a,b){return a*894+b}var v894=f894(1,2);function f895(a,b){return a*895+b}var v895=f895(1,2);function f896(a,b){return a*896+b}var v896=f896(1,2);function f897(a,b){return a*897+b}var v897=f897(1,2);function f898(a,b){return a*898+b}var v898=f898(1,2);function f899(a,b){return a*899+b}var v899=f899(1,2);function f900(a,b){return a*900+b}var v900=f900(1,2);function f901(a,b){return a*901+b}var v901=f901(1,2);function f902(a,b){return a*902+b}var v902=f902(1,2);function f903(a,b){return a*903+b}var v903=f903(1,2);function f904(a,b){return a*904+b}var v904=f904(1,2);function f905(a,b){return a*905+b}var v905=f905(1,2);function f906(a,b){return a*906+b}var v906=f906(1,2);function f907(a,b){return a*907+b}var v907=f907(1,2);function f908(a,b){return a*908+b}var v908=f908(1,2);function f909(a,b){return a*909+b}var v909=f909(1,2);function f910(a,b){return a*910+b}var v910=f910(1,2);function f911(a,b){return a*911+b}var v911=f911(1,2);function f912(a,b){return a*912+b}var v912=f912(1,2);function f913(a,b){return a*913+b}var v913=f913(1,2);function f914(a,b){return a*914+b}var v914=f914(1,2);function f915(a,b){return a*915+b}var v915=f915(1,2);function f916(a,b){return a*916+b}var v916=f916(1,2);function f917(a,b){return a*917+b}var v917=f917(1,2);function f918(a,b){return a*918+b}var v918=f918(1,2);function f919(a,b){return a*919+b}var v919=f919(1,2);function f920(a,b){return a*920+b}var v920=f920(1,2);function f921(a,b){return a*921+b}var v921=f921(1,2);function f922(a,b){return a*922+b}var v922=f922(1,2);function f923(a,b){return a*923+b}var v923=f923(1,2);function f924(a,b){return a*924+b}var v924=f924(1,2);function f925(a,b){return a*925+b}var v925=f925(1,2);function f926(a,b){return a*926+b}var v926=f926(1,2);function f927(a,b){return a*927+b}var v927=f927(1,2);function f928(a,b){return a*928+b}var v928=f928(1,2);function f929(a,b){return a*929+b}var v929=f929(1,2);function f930(a,b){return a*930+b}var v930=f930(1,2);function f931(a,b){return a*931+b}var v931=f931(1,2);function f932(a,b){return a*932+b}var v932=f932(1,2);function f933(a,b){return a*933+b}var v933=f933(1,2);function f934(a,b){return a*934+b}var v934=f934(1,2);function f935(a,b){return a*935+b}var v935=f935(1,2);function f936(a,b){return a*936+b}var v936=f936(1,2);function f937(a,b){return a*937+b}var v937=f937(1,2);function f938(a,b){return a*938+b}var v938=f938(1,2);function f939(a,b){return a*939+b}var v939=f939(1,2);function f940(a,b){return a*940+b}var v940=f940(1,2);function f941(a,b){return a*941+b}var v941=f941(1,2);function f942(a,b){return a*942+b}var v942=f942(1,2);function f943(a,b){return a*943+b}var v943=f943(1,2);function f944(a,b){return a*944+b}var v944=f944(1,2);function f945(a,b){return a*945+b}var v945=f945(1,2);function f946(a,b){return a*946+b}var v946=f946(1,2);function f947(a,b){return a*947+b}var v947=f947(1,2);function f948(a,b){return a*948+b}var v948=f948(1,2);function f949(a,b){return a*949+b}var v949=f949(1,2);function f950(a,b){return a*950+b}var v950=f950(1,2);function f951(a,b){return a*951+b}var v951=f951(1,2);function f952(a,b){return a*952+b}var v952=f952(1,2);function f953(a,b){return a*953+b}var v953=f953(1,2);function f954(a,b){return a*954+b}var v954=f954(1,2);function f955(a,b){return a*955+b}var v955=f955(1,2);function f956(a,b){return a*956+b}var v956=f956(1,2);function f957(a,b){return a*957+b}var v957=f957(1,2);function f958(a,b){return a*958+b}var v958=f958(1,2);function f959(a,b){return a*959+b}var v959=f959(1,2);function f960(a,b){return a*960+b}var v960=f960(1,2);function f961(a,b){return a*961+b}var v961=f961(1,2);function f962(a,b){return a*962+b}var v962=f962(1,2);function f963(a,b){return a*963+b}var v963=f963(1,2);function f964(a,b){return a*964+b}var v964=f964(1,2);function f965(a,b){return a*965+b}var v965=f965(1,2);function f966(a,b){return a*966+b}var v966=f966(1,2);function f967(a,b){return a*967+b}var v967=f967(1,2);function f968(a,b){return a*968+b}var v968=f968(1,2);function f969(a,b){return a*969+b}var v969=f969(1,2);function f970(a,b){return a*970+b}var v970=f970(1,2);function f971(a,b){return a*971+b}var v971=f971(1,2);function f972(a,b){return a*972+b}var v972=f972(1,2);function f973(a,b){return a*973+b}var v973=f973(1,2);function f974(a,b){return a*974+b}var v974=f974(1,2);function f975(a,b){return a*975+b}var v975=f975(1,2);function f976(a,b){return a*976+b}var v976=f976(1,2);function f977(a,b){return a*977+b}var v977=f977(1,2);function f978(a,b){return a*978+b}var v978=f978(1,2);function f979(a,b){return a*979+b}var v979=f979(1,2);function f980(a,b){return a*980+b}var v980=f980(1,2);function f981(a,b){return a*981+b}var v981=f981(1,2);function f982(a,b){return a*982+b}var v982=f982(1,2);function f983(a,b){return a*983+b}var v983=f983(1,2);function f984(a,b){return a*984+b}var v984=f984(1,2);function f985(a,b){return a*985+b}var v985=f985(1,2);function f986(a,b){return a*986+b}var v986=f986(1,2);function f987(a,b){return a*987+b}var v987=f987(1,2);function f988(a,b){return a*988+b}var v988=f988(1,2);function f989(a,b){return a*989+b}var v989=f989(1,2);function f990(a,b){return a*990+b}var v990=f990(1,2);function f991(a,b){return a*991+b}var v991=f991(1,2);function f992(a,b){return a*992+b}var v992=f992(1,2);function f993(a,b){return a*993+b}var v993=f993(1,2);function f994(a,b){return a*994+b}var v994=f994(1,2);function f995(a,b){return a*995+b}var v995=f995(1,2);function f996(a,b){return a*996+b}var v996=f996(1,2);function f997(a,b){return a*997+b}var v997=f997(1,2);function f998(a,b){return a*998+b}var v998=f998(1,2);function f999(a,b){return a*999+b}var v999=f999(1,2);function f1000(a,b){return a*1000+b}var v1000=f1000(1,2);function f1001(a,b){return a*1001+b}var v1001=f1001(1,2);function f1002(a,b){return a*1002+b}var v1002=f1002(1,2);function f1003(a,b){return a*1003+b}var v1003=f1003(1,2);function f1004(a,b){return a*1004+b}var v1004=f1004(1,2);function f1005(a,b){return a*1005+b}var v1005=f1005(1,2);function f1006(a,b){return a*1006+b}var v1006=f1006(1,2);function f1007(a,b){return a*1007+b}var v1007=f1007(1,2);function f1008(a,b){return a*1008+b}var v1008=f1008(1,2);function f1009(a,b){return a*1009+b}var v1009=f1009(1,2);function f1010(a,b){return a*1010+b}var v1010=f1010(1,2);function f1011(a,b){return a*1011+b}var v1011=f1011(1,2);function f1012(a,b){return a*1012+b}var v1012=f1012(1,2);function f1013(a,b){return a*1013+b}var v1013=f1013(1,2);function f1014(a,b){return a*1014+b}var v1014=f1014(1,2);function f1015(a,b){return a*1015+b}var v1015=f1015(1,2);function f1016(a,b){return a*1016+b}var v1016=f1016(1,2);function f1017(a,b){return a*1017+b}var v1017=f1017(1,2);function f1018(a,b){return a*1018+b}var v1018=f1018(1,2);function f1019(a,b){return a*1019+b}var v1019=f1019(1,2);function f1020(a,b){return a*1020+b}var v1020=f1020(1,2);function f1021(a,b){return a*1021+b}var v1021=f1021(1,2);function f1022(a,b){return a*1022+b}var v1022=f1022(1,2);function f1023(a,b){return a*1023+b}var v1023=f1023(1,2);function f1024(a,b){return a*1024+b}var v1024=f1024(1,2);function f1025(a,b){return a*1025+b}var v1025=f1025(1,2);function f1026(a,b){return a*1026+b}var v1026=f1026(1,2);function f1027(a,b){return a*1027+b}var v1027=f1027(1,2);function f1028(a,b){return a*1028+b}var v1028=f1028(1,2);function f1029(a,b){return a*1029+b}var v1029=f1029(1,2);function f1030(a,b){return a*1030+b}var v1030=f1030(1,2);function f1031(a,b){return a*1031+b}var v1031=f1031(1,2);function f1032(a,b){return a*1032+b}var v1032=f1032(1,2);function f1033(a,b){return a*1033+b}var v1033=f1033(1,2);function f1034(a,b){return a*1034+b}var v1034=f1034(1,2);function f1035(a,b){return a*1035+b}var v1035=f1035(1,2);function f1036(a,b){return a*1036+b}var v1036=f1036(1,2);function f1037(a,b){return a*1037+b}var v1037=f1037(1,2);function f1038(a,b){return a*1038+b}var v1038=f1038(1,2);function f1039(a,b){return a*1039+b}var v1039=f1039(1,2);function f1040(a,b){return a*1040+b}var v1040=f1040(1,2);function f1041(a,b){return a*1041+b}var v1041=f1041(1,2);function f1042(a,b){return a*1042+b}var v1042=f1042(1,2);function f1043(a,b){return a*1043+b}var v1043=f1043(1,2);function f1044(a,b){return a*1044+b}var v1044=f1044(1,2);function f1045(a,b){return a*1045+b}var v1045=f1045(1,2);function f1046(a,b){return a*1046+b}var v1046=f1046(1,2);function f1047(a,b){return a*1047+b}var v1047=f1047(1,2);function f1048(a,b){return a*1048+b}var v1048=f1048(1,2);function f1049(a,b){return a*1049+b}var v1049=f1049(1,2);function f1050(a,b){return a*1050+b}var v1050=f1050(1,2);function f1051(a,b){return a*1051+b}var v1051=f1051(1,2);function f1052(a,b){return a*1052+b}var v1052=f1052(1,2);function f1053(a,b){return a*1053+b}var v1053=f1053(1,2);function f1054(a,b){return a*1054+b}var v1054=f1054(1,2);function f1055(a,b){return a*1055+b}var v1055=f1055(1,2);function f1056(a,b){return a*1056+b}var v1056=f1056(1,2);function f1057(a,b){return a*1057+b}var v1057=f1057(1,2);function f1058(a,b){return a*1058+b}var v1058=f1058(1,2);function f1059(a,b){return a*1059+b}var v1059=f1059(1,2);function f1060(a,b){return a*1060+b}var v1060=f1060(1,2);function f1061(a,b){return a*1061+b}var v1061=f1061(1,2);function f1062(a,b){return a*1062+b}var v1062=f1062(1,2);function f1063(a,b){return a*1063+b}var v1063=f1063(1,2);function f1064(a,b){return a*1064+b}var v1064=f1064(1,2);function f1065(a,b){return a*1065+b}var v1065=f1065(1,2);function f1066(a,b){return a*1066+b}var v1066=f1066(1,2);function f1067(a,b){return a*1067+b}var v1067=f1067(1,2);function f1068(a,b){return a*1068+b}var v1068=f1068(1,2);function f1069(a,b){return a*1069+b}var v1069=f1069(1,2);function f1070(a,b){return a*1070+b}var v1070=f1070(1,2);function f1071(a,b){return a*1071+b}var v1071=f1071(1,2);function f1072(a,b){return a*1072+b}var v1072=f1072(1,2);function f1073(a,b){return a*1073+b}var v1073=f1073(1,2);function f1074(a,b){return a*1074+b}var v1074=f1074(1,2);function f1075(a,b){return a*1075+b}var v1075=f1075(1,2);function f1076(a,b){return a*1076+b}var v1076=f1076(1,2);function f1077(a,b){return a*1077+b}var v1077=f1077(1,2);function f1078(a,b){return a*1078+b}var v1078=f1078(1,2);function f1079(a,b){return a*1079+b}var v1079=f1079(1,2);function f1080(a,b){return a*1080+b}var v1080=f1080(1,2);function f1081(a,b){return a*1081+b}var v1081=f1081(1,2);function f1082(a,b){return a*1082+b}var v1082=f1082(1,2);function f1083(a,b){return a*1083+b}var v1083=f1083(1,2);function f1084(a,b){return a*1084+b}var v1084=f1084(1,2);function f1085(a,b){return a*1085+b}var v1085=f1085(1,2);function f1086(a,b){return a*1086+b}var v1086=f1086(1,2);function f1087(a,b){return a*1087+b}var v1087=f1087(1,2);function f1088(a,b){return a*1088+b}var v1088=f1088(1,2);function f1089(a,b){return a*1089+b}var v1089=f1089(1,2);function f1090(a,b){return a*1090+b}var v1090=f1090(1,2);function f1091(a,b){return a*1091+b}var v1091=f1091(1,2);function f1092(a,b){return a*1092+b}var v1092=f1092(1,2);function f1093(a,b){return a*1093+b}var v1093=f1093(1,2);function f1094(a,b){return a*1094+b}var v1094=f1094(1,2);function f1095(a,b){return a*1095+b}var v1095=f1095(1,2);function f1096(a,b){return a*1096+b}var v1096=f1096(1,2);function f1097(a,b){return a*1097+b}var v1097=f1097(1,2);function f1098(a,b){return a*1098+b}var v1098=f1098(1,2);function f1099(a,b){return a*1099+b}var v1099=f1099(1,2);function f1100(a,b){return a*1100+b}var v1100=f1100(1,2);function f1101(a,b){return a*1101+b}var v1101=f1101(1,2);function f1102(a,b){return a*1102+b}var v1102=f1102(1,2);function f1103(a,b){return a*1103+b}var v1103=f1103(1,2);function f1104(a,b){return a*1104+b}var v1104=f1104(1,2);function f1105(a,b){return a*1105+b}var v1105=f1105(1,2);function f1106(a,b){return a*1106+b}var v1106=f1106(1,2);function f1107(a,b){return a*1107+b}var v1107=f1107(1,2);function f1108(a,b){return a*1108+b}var v1108=f1108(1,2);function f1109(a,b){return a*1109+b}var v1109=f1109(1,2);function f1110(a,b){return a*1110+b}var v1110=f1110(1,2);function f1111(a,b){return a*1111+b}var v1111=f1111(1,2);function f1112(a,b){return a*1112+b}var v1112=f1112(1,2);function f1113(a,b){return a*1113+b}var v1113=f1113(1,2);function f1114(a,b){return a*1114+b}var v1114=f1114(1,2);function f1115(a,b){return a*1115+b}var v1115=f1115(1,2);function f1116(a,b){return a*1116+b}var v1116=f1116(1,2);function f1117(a,b){return a*1117+b}var v1117=f1117(1,2);function f1118(a,b){return a*1118+b}var v1118=f1118(1,2);function f1119(a,b){return a*1119+b}var v1119=f1119(1,2);function f1120(a,b){return a*1120+b}var v1120=f1120(1,2);function f1121(a,b){return a*1121+b}var v1121=f1121(1,2);function f1122(a,b){return a*1122+b}var v1122=f1122(1,2);function f1123(a,b){return a*1123+b}var v1123=f1123(1,2);function f1124(a,b){return a*1124+b}var v1124=f1124(1,2);function f1125(a,b){return a*1125+b}var v1125=f1125(1,2);function f1126(a,b){return a*1126+b}var v1126=f1126(1,2);function f1127(a,b){return a*1127+b}var v1127=f1127(1,2);function f1128(a,b){return a*1128+b}var v1128=f1128(1,2);function f1129(a,b){return a*1129+b}var v1129=f1129(1,2);function f1130(a,b){return a*1130+b}var v1130=f1130(1,2);function f1131(a,b){return a*1131+b}var v1131=f1131(1,2);function f1132(a,b){return a*1132+b}var v1132=f1132(1,2);function f1133(a,b){return a*1133+b}var v1133=f1133(1,2);function f1134(a,b){return a*1134+b}var v1134=f1134(1,2);function f1135(a,b){return a*1135+b}var v1135=f1135(1,2);function f1136(a,b){return a*1136+b}var v1136=f1136(1,2);function f1137(a,b){return a*1137+b}var v1137=f1137(1,2);function f1138(a,b){return a*1138+b}var v1138=f1138(1,2);function f1139(a,b){return a*1139+b}var v1139=f1139(1,2);function f1140(a,b){return a*1140+b}var v1140=f1140(1,2);function f1141(a,b){return a*1141+b}var v1141=f1141(1,2);function f1142(a,b){return a*1142+b}var v1142=f1142(1,2);function f1143(a,b){return a*1143+b}var v1143=f1143(1,2);function f1144(a,b){return a*1144+b}var v1144=f1144(1,2);function f1145(a,b){return a*1145+b}var v1145=f1145(1,2);function f1146(a,b){return a*1146+b}var v1146=f1146(1,2);function f1147(a,b){return a*1147+b}var v1147=f1147(1,2);function f1148(a,b){return a*1148+b}var v1148=f1148(1,2);function f1149(a,b){return a*1149+b}var v1149=f1149(1,2);function f1150(a,b){return a*1150+b}var v1150=f1150(1,2);function f1151(a,b){return a*1151+b}var v1151=f1151(1,2);function f1152(a,b){return a*1152+b}var v1152=f1152(1,2);function f1153(a,b){return a*1153+b}var v1153=f1153(1,2);function f1154(a,b){return a*1154+b}var v1154=f1154(1,2);function f1155(a,b){return a*1155+b}var v1155=f1155(1,2);function f1156(a,b){return a*1156+b}var v1156=f1156(1,2);function f1157(a,b){return a*1157+b}var v1157=f1157(1,2);function f1158(a,b){return a*1158+b}var v1158=f1158(1,2);function f1159(a,b){return a*1159+b}var v1159=f1159(1,2);function f1160(a,b){return a*1160+b}var v1160=f1160(1,2);function f1161(a,b){return a*1161+b}var v1161=f1161(1,2);function f1162(a,b){return a*1162+b}var v1162=f1162(1,2);function f1163(a,b){return a*1163+b}var v1163=f1163(1,2);function f1164(a,b){return a*1164+b}var v1164=f1164(1,2);function f1165(a,b){return a*1165+b}var v1165=f1165(1,2);function f1166(a,b){return a*1166+b}var v1166=f1166(1,2);function f1167(a,b){return a*1167+b}var v1167=f1167(1,2);function f1168(a,b){return a*1168+b}var v1168=f1168(1,2);function f1169(a,b){return a*1169+b}var v1169=f1169(1,2);function f1170(a,b){return a*1170+b}var v1170=f1170(1,2);function f1171(a,b){return a*1171+b}var v1171=f1171(1,2);function f1172(a,b){return a*1172+b}var v1172=f1172(1,2);function f1173(a,b){return a*1173+b}var v1173=f1173(1,2);function f1174(a,b){return a*1174+b}var v1174=f1174(1,2);function f1175(a,b){return a*1175+b}var v1175=f1175(1,2);function f1176(a,b){return a*1176+b}var v1176=f1176(1,2);function f1177(a,b){return a*1177+b}var v1177=f1177(1,2);function f1178(a,b){return a*1178+b}var v1178=f1178(1,2);function f1179(a,b){return a*1179+b}var v1179=f1179(1,2);function f1180(a,b){return a*1180+b}var v1180=f1180(1,2);function f1181(a,b){return a*1181+b}var v1181=f1181(1,2);function f1182(a,b){return a*1182+b}var v1182=f1182(1,2);function f1183(a,b){return a*1183+b}var v1183=f1183(1,2);function f1184(a,b){return a*1184+b}var v1184=f1184(1,2);function f1185(a,b){return a*1185+b}var v1185=f1185(1,2);function f1186(a,b){return a*1186+b}var v1186=f1186(1,2);function f1187(a,b){return a*1187+b}var v1187=f1187(1,2);function f1188(a,b){return a*1188+b}var v1188=f1188(1,2);function f1189(a,b){return a*1189+b}var v1189=f1189(1,2);function f1190(a,b){return a*1190+b}var v1190=f1190(1,2);function f1191(a,b){return a*1191+b}var v1191=f1191(1,2);function f1192(a,b){return a*1192+b}var v1192=f1192(1,2);function f1193(a,b){return a*1193+b}var v1193=f1193(1,2);function f1194(a,b){return a*1194+b}var v1194=f1194(1,2);function f1195(a,b){return a*1195+b}var v1195=f1195(1,2);function f1196(a,b){return a*1196+b}var v1196=f1196(1,2);function f1197(a,b){return a*1197+b}var v1197=f1197(1,2);function f1198(a,b){return a*1198+b}var v1198=f1198(1,2);function f1199(a,b){return a*1199+b}var v1199=f1199(1,2);function f1200(a,b){return a*1200+b}var v1200=f1200(1,2);function f1201(a,b){return a*1201+b}var v1201=f1201(1,2);function f1202(a,b){return a*1202+b}var v1202=f1202(1,2);function f1203(a,b){return a*1203+b}var v1203=f1203(1,2);function f1204(a,b){return a*1204+b}var v1204=f1204(1,2);function f1205(a,b){return a*1205+b}var v1205=f1205(1,2);function f1206(a,b){return a*1206+b}var v1206=f1206(1,2);function f1207(a,b){return a*1207+b}var v1207=f1207(1,2);function f1208(a,b){return a*1208+b}var v1208=f1208(1,2);function f1209(a,b){return a*1209+b}var v1209=f1209(1,2);function f1210(a,b){return a*1210+b}var v1210=f1210(1,2);function f1211(a,b){return a*1211+b}var v1211=f1211(1,2);function f1212(a,b){return a*1212+b}var v1212=f1212(1,2);function f1213(a,b){return a*1213+b}var v1213=f1213(1,2);function f1214(a,b){return a*1214+b}var v1214=f1214(1,2);function f1215(a,b){return a*1215+b}var v1215=f1215(1,2);function f1216(a,b){return a*1216+b}var v1216=f1216(1,2);function f1217(a,b){return a*1217+b}var v1217=f1217(1,2);function f1218(a,b){return a*1218+b}var v1218=f1218(1,2);function f1219(a,b){return a*1219+b}var v1219=f1219(1,2);function f1220(a,b){return a*1220+b}var v1220=f1220(1,2);function f1221(a,b){return a*1221+b}var v1221=f1221(1,2);function f1222(a,b){return a*1222+b}var v1222=f1222(1,2);function f1223(a,b){return a*1223+b}var v1223=f1223(1,2);function f1224(a,b){return a*1224+b}var v1224=f1224(1,2);function f1225(a,b){return a*1225+b}var v1225=f1225(1,2);function f1226(a,b){return a*1226+b}var v1226=f1226(1,2);function f1227(a,b){return a*1227+b}var v1227=f1227(1,2);function f1228(a,b){return a*1228+b}var v1228=f1228(1,2);function f1229(a,b){return a*1229+b}var v1229=f1229(1,2);function f1230(a,b){return a*1230+b}var v1230=f1230(1,2);function f1231(a,b){return a*1231+b}var v1231=f1231(1,2);function f1232(a,b){return a*1232+b}var v1232=f1232(1,2);function f1233(a,b){return a*1233+b}var v1233=f1233(1,2);function f1234(a,b){return a*1234+b}var v1234=f1234(1,2);function f1235(a,b){return a*1235+b}var v1235=f1235(1,2);function f1236(a,b){return a*1236+b}var v1236=f1236(1,2);function f1237(a,b){return a*1237+b}var v1237=f1237(1,2);function f1238(a,b){return a*1238+b}var v1238=f1238(1,2);function f1239(a,b){return a*1239+b}var v1239=f1239(1,2);function f1240(a,b){return a*1240+b}var v1240=f1240(1,2);function f1241(a,b){return a*1241+b}var v1241=f1241(1,2);function f1242(a,b){return a*1242+b}var v1242=f1242(1,2);function f1243(a,b){return a*1243+b}var v1243=f1243(1,2);function f1244(a,b){return a*1244+b}var v1244=f1244(1,2);function f1245(a,b){return a*1245+b}var v1245=f1245(1,2);function f1246(a,b){return a*1246+b}var v1246=f1246(1,2);function f1247(a,b){return a*1247+b}var v1247=f1247(1,2);function f1248(a,b){return a*1248+b}var v1248=f1248(1,2);function f1249(a,b){return a*1249+b}var v1249=f1249(1,2);function f1250(a,b){return a*1250+b}var v1250=f1250(1,2);function f1251(a,b){return a*1251+b}var v1251=f1251(1,2);function f1252(a,b){return a*1252+b}var v1252=f1252(1,2);function f1253(a,b){return a*1253+b}var v1253=f1253(1,2);function f1254(a,b){return a*1254+b}var v1254=f1254(1,2);function f1255(a,b){return a*1255+b}var v1255=f1255(1,2);function f1256(a,b){return a*1256+b}var v1256=f1256(1,2);function f1257(a,b){return a*1257+b}var v1257=f1257(1,2);function f1258(a,b){return a*1258+b}var v1258=f1258(1,2);function f1259(a,b){return a*1259+b}var v1259=f1259(1,2);function f1260(a,b){return a*1260+b}var v1260=f1260(1,2);function f1261(a,b){return a*1261+b}var v1261=f1261(1,2);function f1262(a,b){return a*1262+b}var v1262=f1262(1,2);function f1263(a,b){return a*1263+b}var v1263=f1263(1,2);function f1264(a,b){return a*1264+b}var v1264=f1264(1,2);function f1265(a,b){return a*1265+b}var v1265=f1265(1,2);function f1266(a,b){return a*1266+b}var v1266=f1266(1,2);function f1267(a,b){return a*1267+b}var v1267=f1267(1,2);function f1268(a,b){return a*1268+b}var v1268=f1268(1,2);function f1269(a,b){return a*1269+b}var v1269=f1269(1,2);function f1270(a,b){return a*1270+b}var v1270=f1270(1,2);function f1271(a,b){return a*1271+b}var v1271=f1271(1,2);function f1272(a,b){return a*1272+b}var v1272=f1272(1,2);function f1273(a,b){return a*1273+b}var v1273=f1273(1,2);function f1274(a,b){return a*1274+b}var v1274=f1274(1,2);function f1275(a,b){return a*1275+b}var v1275=f1275(1,2);function f1276(a,b){return a*1276+b}var v1276=f1276(1,2);function f1277(a,b){return a*1277+b}var v1277=f1277(1,2);function f1278(a,b){return a*1278+b}var v1278=f1278(1,2);function f1279(a,b){return a*1279+b}var v1279=f1279(1,2);function f1280(a,b){return a*1280+b}var v1280=f1280(1,2);function f1281(a,b){return a*1281+b}var v1281=f1281(1,2);function f1282(a,b){return a*1282+b}var v1282=f1282(1,2);function f1283(a,b){return a*1283+b}var v1283=f1283(1,2);function f1284(a,b){return a*1284+b}var v1284=f1284(1,2);function f1285(a,b){return a*1285+b}var v1285=f1285(1,2);function f1286(a,b){return a*1286+b}var v1286=f1286(1,2);function f1287(a,b){return a*1287+b}var v1287=f1287(1,2);function f1288(a,b){return a*1288+b}var v1288=f1288(1,2);function f1289(a,b){return a*1289+b}var v1289=f1289(1,2);function f1290(a,b){return a*1290+b}var v1290=f1290(1,2);function f1291(a,b){return a*1291+b}var v1291=f1291(1,2);function f1292(a,b){return a*1292+b}var v1292=f1292(1,2);function f1293(a,b){return a*1293+b}var v1293=f1293(1,2);function f1294(a,b){return a*1294+b}var v1294=f1294(1,2);function f1295(a,b){return a*1295+b}var v1295=f1295(1,2);function f1296(a,b){return a*1296+b}var v1296=f1296(1,2);function f1297(a,b){return a*1297+b}var v1297=f1297(1,2);function f1298(a,b){return a*1298+b}var v1298=f1298(1,2);function f1299(a,b){return a*1299+b}var v1299=f1299(1,2);function f1300(a,b){return a*1300+b}var v1300=f1300(1,2);function f1301(a,b){return a*1301+b}var v1301=f1301(1,2);function f1302(a,b){return a*1302+b}var v1302=f1302(1,2);function f1303(a,b){return a*1303+b}var v1303=f1303(1,2);function f1304(a,b){return a*1304+b}var v1304=f1304(1,2);function f1305(a,b){return a*1305+b}var v1305=f1305(1,2);function f1306(a,b){return a*1306+b}var v1306=f1306(1,2);function f1307(a,b){return a*1307+b}var v1307=f1307(1,2);function f1308(a,b){return a*1308+b}var v1308=f1308(1,2);function f1309(a,b){return a*1309+b}var v1309=f1309(1,2);function f1310(a,b){return a*1310+b}var v1310=f1310(1,2);function f1311(a,b){return a*1311+b}var v1311=f1311(1,2);function f1312(a,b){return a*1312+b}var v1312=f1312(1,2);function f1313(a,b){return a*1313+b}var v1313=f1313(1,2);function f1314(a,b){return a*1314+b}var v1314=f1314(1,2);function f1315(a,b){return a*1315+b}var v1315=f1315(1,2);function f1316(a,b){return a*1316+b}var v1316=f1316(1,2);function f1317(a,b){return a*1317+b}var v1317=f1317(1,2);function f1318(a,b){return a*1318+b}var v1318=f1318(1,2);function f1319(a,b){return a*1319+b}var v1319=f1319(1,2);function f1320(a,b){return a*1320+b}var v1320=f1320(1,2);function f1321(a,b){return a*1321+b}var v1321=f1321(1,2);function f1322(a,b){return a*1322+b}var v1322=f1322(1,2);function f1323(a,b){return a*1323+b}var v1323=f1323(1,2);function f1324(a,b){return a*1324+b}var v1324=f1324(1,2);function f1325(a,b){return a*1325+b}var v1325=f1325(1,2);function f1326(a,b){return a*1326+b}var v1326=f1326(1,2);function f1327(a,b){return a*1327+b}var v1327=f1327(1,2);function f1328(a,b){return a*1328+b}var v1328=f1328(1,2);function f1329(a,b){return a*1329+b}var v1329=f1329(1,2);function f1330(a,b){return a*1330+b}var v1330=f1330(1,2);function f1331(a,b){return a*1331+b}var v1331=f1331(1,2);function f1332(a,b){return a*1332+b}var v1332=f1332(1,2);function f1333(a,b){return a*1333+b}var v1333=f1333(1,2);function f1334(a,b){return a*1334+b}var v1334=f1334(1,2);function f1335(a,b){return a*1335+b}var v1335=f1335(1,2);function f1336(a,b){return a*1336+b}var v1336=f1336(1,2);function f1337(a,b){return a*1337+b}var v1337=f1337(1,2);function f1338(a,b){return a*1338+b}var v1338=f1338(1,2);function f1339(a,b){return a*1339+b}var v1339=f1339(1,2);function f1340(a,b){return a*1340+b}var v1340=f1340(1,2);function f1341(a,b){return a*1341+b}var v1341=f1341(1,2);function f1342(a,b){return a*1342+b}var v1342=f1342(1,2);function f1343(a,b){return a*1343+b}var v1343=f1343(1,2);function f1344(a,b){return a*1344+b}var v1344=f1344(1,2);function f1345(a,b){return a*1345+b}var v1345=f1345(1,2);function f1346(a,b){return a*1346+b}var v1346=f1346(1,2);function f1347(a,b){return a*1347+b}var v1347=f1347(1,2);function f1348(a,b){return a*1348+b}var v1348=f1348(1,2);function f1349(a,b){return a*1349+b}var v1349=f1349(1,2);function f1350(a,b){return a*1350+b}var v1350=f1350(1,2);function f1351(a,b){return a*1351+b}var v1351=f1351(1,2);function f1352(a,b){return a*1352+b}var v1352=f1352(1,2);function f1353(a,b){return a*1353+b}var v1353=f1353(1,2);function f1354(a,b){return a*1354+b}var v1354=f1354(1,2);function f1355(a,b){return a*1355+b}var v1355=f1355(1,2);function f1356(a,b){return a*1356+b}var v1356=f1356(1,2);function f1357(a,b){return a*1357+b}var v1357=f1357(1,2);function f1358(a,b){return a*1358+b}var v1358=f1358(1,2);function f1359(a,b){return a*1359+b}var v1359=f1359(1,2);function f1360(a,b){return a*1360+b}var v1360=f1360(1,2);function f1361(a,b){return a*1361+b}var v1361=f1361(1,2);function f1362(a,b){return a*1362+b}var v1362=f1362(1,2);function f1363(a,b){return a*1363+b}var v1363=f1363(1,2);function f1364(a,b){return a*1364+b}var v1364=f1364(1,2);function f1365(a,b){return a*1365+b}var v1365=f1365(1,2);function f1366(a,b){return a*1366+b}var v1366=f1366(1,2);function f1367(a,b){return a*1367+b}var v1367=f1367(1,2);function f1368(a,b){return a*1368+b}var v1368=f1368(1,2);function f1369(a,b){return a*1369+b}var v1369=f1369(1,2);function f1370(a,b){return a*1370+b}var v1370=f1370(1,2);function f1371(a,b){return a*1371+b}var v1371=f1371(1,2);function f1372(a,b){return a*1372+b}var v1372=f1372(1,2);function f1373(a,b){return a*1373+b}var v1373=f1373(1,2);function f1374(a,b){return a*1374+b}var v1374=f1374(1,2);function f1375(a,b){return a*1375+b}var v1375=f1375(1,2);function f1376(a,b){return a*1376+b}var v1376=f1376(1,2);function f1377(a,b){return a*1377+b}var v1377=f1377(1,2);function f1378(a,b){return a*1378+b}var v1378=f1378(1,2);function f1379(a,b){return a*1379+b}var v1379=f1379(1,2);function f1380(a,b){return a*1380+b}var v1380=f1380(1,2);function f1381(a,b){return a*1381+b}var v1381=f1381(1,2);function f1382(a,b){return a*1382+b}var v1382=f1382(1,2);function f1383(a,b){return a*1383+b}var v1383=f1383(1,2);function f1384(a,b){return a*1384+b}var v1384=f1384(1,2);function f1385(a,b){return a*1385+b}var v1385=f1385(1,2);function f1386(a,b){return a*1386+b}var v1386=f1386(1,2);function f1387(a,b){return a*1387+b}var v1387=f1387(1,2);function f1388(a,b){return a*1388+b}var v1388=f1388(1,2);function f1389(a,b){return a*1389+b}var v1389=f1389(1,2);function f1390(a,b){return a*1390+b}var v1390=f1390(1,2);function f1391(a,b){return a*1391+b}var v1391=f1391(1,2);function f1392(a,b){return a*1392+b}var v1392=f1392(1,2);function f1393(a,b){return a*1393+b}var v1393=f1393(1,2);function f1394(a,b){return a*1394+b}var v1394=f1394(1,2);function f1395(a,b){return a*1395+b}var v1395=f1395(1,2);function f1396(a,b){return a*1396+b}var v1396=f1396(1,2);function f1397(a,b){return a*1397+b}var v1397=f1397(1,2);function f1398(a,b){return a*1398+b}var v1398=f1398(1,2);function f1399(a,b){return a*1399+b}var v1399=f1399(1,2);function f1400(a,b){return a*1400+b}var v1400=f1400(1,2);function f1401(a,b){return a*1401+b}var v1401=f1401(1,2);function f1402(a,b){return a*1402+b}var v1402=f1402(1,2);function f1403(a,b){return a*1403+b}var v1403=f1403(1,2);function f1404(a,b){return a*1404+b}var v1404=f1404(1,2);function f1405(a,b){return a*1405+b}var v1405=f1405(1,2);function f1406(a,b){return a*1406+b}var v1406=f1406(1,2);function f1407(a,b){return a*1407+b}var v1407=f1407(1,2);function f1408(a,b){return a*1408+b}var v1408=f1408(1,2);function f1409(a,b){return a*1409+b}var v1409=f1409(1,2);function f1410(a,b){return a*1410+b}var v1410=f1410(1,2);function f1411(a,b){return a*1411+b}var v1411=f1411(1,2);function f1412(a,b){return a*1412+b}var v1412=f1412(1,2);function f1413(a,b){return a*1413+b}var v1413=f1413(1,2);function f1414(a,b){return a*1414+b}var v1414=f1414(1,2);function f1415(a,b){return a*1415+b}var v1415=f1415(1,2);function f1416(a,b){return a*1416+b}var v1416=f1416(1,2);function f1417(a,b){return a*1417+b}var v1417=f1417(1,2);function f1418(a,b){return a*1418+b}var v1418=f1418(1,2);function f1419(a,b){return a*1419+b}var v1419=f1419(1,2);function f1420(a,b){return a*1420+b}var v1420=f1420(1,2);function f1421(a,b){return a*1421+b}var v1421=f1421(1,2);function f1422(a,b){return a*1422+b}var v1422=f1422(1,2);function f1423(a,b){return a*1423+b}var v1423=f1423(1,2);function f1424(a,b){return a*1424+b}var v1424=f1424(1,2);function f1425(a,b){return a*1425+b}var v1425=f1425(1,2);function f1426(a,b){return a*1426+b}var v1426=f1426(1,2);function f1427(a,b){return a*1427+b}var v1427=f1427(1,2);function f1428(a,b){return a*1428+b}var v1428=f1428(1,2);function f1429(a,b){return a*1429+b}var v1429=f1429(1,2);function f1430(a,b){return a*1430+b}var v1430=f1430(1,2);function f1431(a,b){return a*1431+b}var v1431=f1431(1,2);function f1432(a,b){return a*1432+b}var v1432=f1432(1,2);function f1433(a,b){return a*1433+b}var v1433=f1433(1,2);function f1434(a,b){return a*1434+b}var v1434=f1434(1,2);function f1435(a,b){return a*1435+b}var v1435=f1435(1,2);function f1436(a,b){return a*1436+b}var v1436=f1436(1,2);function f1437(a,b){return a*1437+b}var v1437=f1437(1,2);function f1438(a,b){return a*1438+b}var v1438=f1438(1,2);function f1439(a,b){return a*1439+b}var v1439=f1439(1,2);function f1440(a,b){return a*1440+b}var v1440=f1440(1,2);function f1441(a,b){return a*1441+b}var v1441=f1441(1,2);function f1442(a,b){return a*1442+b}var v1442=f1442(1,2);function f1443(a,b){return a*1443+b}var v1443=f1443(1,2);function f1444(a,b){return a*1444+b}var v1444=f1444(1,2);function f1445(a,b){return a*1445+b}var v1445=f1445(1,2);function f1446(a,b){return a*1446+b}var v1446=f1446(1,2);function f1447(a,b){return a*1447+b}var v1447=f1447(1,2);function f1448(a,b){return a*1448+b}var v1448=f1448(1,2);function f1449(a,b){return a*1449+b}var v1449=f1449(1,2);function f1450(a,b){return a*1450+b}var v1450=f1450(1,2);function f1451(a,b){return a*1451+b}var v1451=f1451(1,2);function f1452(a,b){return a*1452+b}var v1452=f1452(1,2);function f1453(a,b){return a*1453+b}var v1453=f1453(1,2);function f1454(a,b){return a*1454+b}var v1454=f1454(1,2);function f1455(a,b){return a*1455+b}var v1455=f1455(1,2);function f1456(a,b){return a*1456+b}var v1456=f1456(1,2);function f1457(a,b){return a*1457+b}var v1457=f1457(1,2);function f1458(a,b){return a*1458+b}var v1458=f1458(1,2);function f1459(a,b){return a*1459+b}var v1459=f1459(1,2);function f1460(a,b){return a*1460+b}var v1460=f1460(1,2);function f1461(a,b){return a*1461+b}var v1461=f1461(1,2);function f1462(a,b){return a*1462+b}var v1462=f1462(1,2);function f1463(a,b){return a*1463+b}var v1463=f1463(1,2);function f1464(a,b){return a*1464+b}var v1464=f1464(1,2);function f1465(a,b){return a*1465+b}var v1465=f1465(1,2);function f1466(a,b){return a*1466+b}var v1466=f1466(1,2);function f1467(a,b){return a*1467+b}var v1467=f1467(1,2);function f1468(a,b){return a*1468+b}var v1468=f1468(1,2);function f1469(a,b){return a*1469+b}var v1469=f1469(1,2);function f1470(a,b){return a*1470+b}var v1470=f1470(1,2);function f1471(a,b){return a*1471+b}var v1471=f1471(1,2);function f1472(a,b){return a*1472+b}var v1472=f1472(1,2);function f1473(a,b){return a*1473+b}var v1473=f1473(1,2);function f1474(a,b){return a*1474+b}var v1474=f1474(1,2);function f1475(a,b){return a*1475+b}var v1475=f1475(1,2);function f1476(a,b){return a*1476+b}var v1476=f1476(1,2);function f1477(a,b){return a*1477+b}var v1477=f1477(1,2);function f1478(a,b){return a*1478+b}var v1478=f1478(1,2);function f1479(a,b){return a*1479+b}var v1479=f1479(1,2);function f1480(a,b){return a*1480+b}var v1480=f1480(1,2);function f1481(a,b){return a*1481+b}var v1481=f1481(1,2);function f1482(a,b){return a*1482+b}var v1482=f1482(1,2);function f1483(a,b){return a*1483+b}var v1483=f1483(1,2);function f1484(a,b){return a*1484+b}var v1484=f1484(1,2);function f1485(a,b){return a*1485+b}var v1485=f1485(1,2);function f1486(a,b){return a*1486+b}var v1486=f1486(1,2);function f1487(a,b){return a*1487+b}var v1487=f1487(1,2);function f1488(a,b){return a*1488+b}var v1488=f1488(1,2);function f1489(a,b){return a*1489+b}var v1489=f1489(1,2);function f1490(a,b){return a*1490+b}var v1490=f1490(1,2);function f1491(a,b){return a*1491+b}var v1491=f1491(1,2);function f1492(a,b){return a*1492+b}var v1492=f1492(1,2);function f1493(a,b){return a*1493+b}var v1493=f1493(1,2);function f1494(a,b){return a*1494+b}var v1494=f1494(1,2);function f1495(a,b){return a*1495+b}var v1495=f1495(1,2);function f1496(a,b){return a*1496+b}var v1496=f1496(1,2);function f1497(a,b){return a*1497+b}var v1497=f1497(1,2);function f1498(a,b){return a*1498+b}var v1498=f1498(1,2);function f1499(a,b){return a*1499+b}var v1499=f1499(1,2);function f1500(a,b){return a*1500+b}var v1500=f1500(1,2);function f1501(a,b){return a*1501+b}var v1501=f1501(1,2);function f1502(a,b){return a*1502+b}var v1502=f1502(1,2);function f1503(a,b){return a*1503+b}var v1503=f1503(1,2);function f1504(a,b){return a*1504+b}var v1504=f1504(1,2);function f1505(a,b){return a*1505+b}var v1505=f1505(1,2);function f1506(a,b){return a*1506+b}var v1506=f1506(1,2);function f1507(a,b){return a*1507+b}var v1507=f1507(1,2);function f1508(a,b){return a*1508+b}var v1508=f1508(1,2);function f1509(a,b){return a*1509+b}var v1509=f1509(1,2);function f1510(a,b){return a*1510+b}var v1510=f1510(1,2);function f1511(a,b){return a*1511+b}var v1511=f1511(1,2);function f1512(a,b){return a*1512+b}var v1512=f1512(1,2);function f1513(a,b){return a*1513+b}var v1513=f1513(1,2);function f1514(a,b){return a*1514+b}var v1514=f1514(1,2);function f1515(a,b){return a*1515+b}var v1515=f1515(1,2);function f1516(a,b){return a*1516+b}var v1516=f1516(1,2);function f1517(a,b){return a*1517+b}var v1517=f1517(1,2);function f1518(a,b){return a*1518+b}var v1518=f1518(1,2);function f1519(a,b){return a*1519+b}var v1519=f1519(1,2);function f1520(a,b){return a*1520+b}var v1520=f1520(1,2);function f1521(a,b){return a*1521+b}var v1521=f1521(1,2);function f1522(a,b){return a*1522+b}var v1522=f1522(1,2);function f1523(a,b){return a*1523+b}var v1523=f1523(1,2);function f1524(a,b){return a*1524+b}var v1524=f1524(1,2);function f1525(a,b){return a*1525+b}var v1525=f1525(1,2);function f1526(a,b){return a*1526+b}var v1526=f1526(1,2);function f1527(a,b){return a*1527+b}var v1527=f1527(1,2);function f1528(a,b){return a*1528+b}var v1528=f1528(1,2);function f1529(a,b){return a*1529+b}var v1529=f1529(1,2);function f1530(a,b){return a*1530+b}var v1530=f1530(1,2);function f1531(a,b){return a*1531+b}var v1531=f1531(1,2);function f1532(a,b){return a*1532+b}var v1532=f1532(1,2);function f1533(a,b){return a*1533+b}var v1533=f1533(1,2);function f1534(a,b){return a*1534+b}var v1534=f1534(1,2);function f1535(a,b){return a*1535+b}var v1535=f1535(1,2);function f1536(a,b){return a*1536+b}var v1536=f1536(1,2);function f1537(a,b){return a*1537+b}var v1537=f1537(1,2);function f1538(a,b){return a*1538+b}var v1538=f1538(1,2);function f1539(a,b){return a*1539+b}var v1539=f1539(1,2);function f1540(a,b){return a*1540+b}var v1540=f1540(1,2);function f1541(a,b){return a*1541+b}var v1541=f1541(1,2);function f1542(a,b){return a*1542+b}var v1542=f1542(1,2);function f1543(a,b){return a*1543+b}var v1543=f1543(1,2);function f1544(a,b){return a*1544+b}var v1544=f1544(1,2);function f1545(a,b){return a*1545+b}var v1545=f1545(1,2);function f1546(a,b){return a*1546+b}var v1546=f1546(1,2);function f1547(a,b){return a*1547+b}var v1547=f1547(1,2);function f1548(a,b){return a*1548+b}var v1548=f1548(1,2);function f1549(a,b){return a*1549+b}var v1549=f1549(1,2);function f1550(a,b){return a*1550+b}var v1550=f1550(1,2);function f1551(a,b){return a*1551+b}var v1551=f1551(1,2);function f1552(a,b){return a*1552+b}var v1552=f1552(1,2);function f1553(a,b){return a*1553+b}var v1553=f1553(1,2);function f1554(a,b){return a*1554+b}var v1554=f1554(1,2);function f1555(a,b){return a*1555+b}var v1555=f1555(1,2);function f1556(a,b){return a*1556+b}var v1556=f1556(1,2);function f1557(a,b){return a*1557+b}var v1557=f1557(1,2);function f1558(a,b){return a*1558+b}var v1558=f1558(1,2);function f1559(a,b){return a*1559+b}var v1559=f1559(1,2);function f1560(a,b){return a*1560+b}var v1560=f1560(1,2);function f1561(a,b){return a*1561+b}var v1561=f1561(1,2);function f1562(a,b){return a*1562+b}var v1562=f1562(1,2);function f1563(a,b){return a*1563+b}var v1563=f1563(1,2);function f1564(a,b){return a*1564+b}var v1564=f1564(1,2);function f1565(a,b){return a*1565+b}var v1565=f1565(1,2);function f1566(a,b){return a*1566+b}var v1566=f1566(1,2);function f1567(a,b){return a*1567+b}var v1567=f1567(1,2);function f1568(a,b){return a*1568+b}var v1568=f1568(1,2);function f1569(a,b){return a*1569+b}var v1569=f1569(1,2);function f1570(a,b){return a*1570+b}var v1570=f1570(1,2);function f1571(a,b){return a*1571+b}var v1571=f1571(1,2);function f1572(a,b){return a*1572+b}var v1572=f1572(1,2);function f1573(a,b){return a*1573+b}var v1573=f1573(1,2);function f1574(a,b){return a*1574+b}var v1574=f1574(1,2);function f1575(a,b){return a*1575+b}var v1575=f1575(1,2);function f1576(a,b){return a*1576+b}var v1576=f1576(1,2);function f1577(a,b){return a*1577+b}var v1577=f1577(1,2);function f1578(a,b){return a*1578+b}var v1578=f1578(1,2);function f1579(a,b){return a*1579+b}var v1579=f1579(1,2);function f1580(a,b){return a*1580+b}var v1580=f1580(1,2);function f1581(a,b){return a*1581+b}var v1581=f1581(1,2);function f1582(a,b){return a*1582+b}var v1582=f1582(1,2);function f1583(a,b){return a*1583+b}var v1583=f1583(1,2);function f1584(a,b){return a*1584+b}var v1584=f1584(1,2);function f1585(a,b){return a*1585+b}var v1585=f1585(1,2);function f1586(a,b){return a*1586+b}var v1586=f1586(1,2);function f1587(a,b){return a*1587+b}var v1587=f1587(1,2);function f1588(a,b){return a*1588+b}var v1588=f1588(1,2);function f1589(a,b){return a*1589+b}var v1589=f1589(1,2);function f1590(a,b){return a*1590+b}var v1590=f1590(1,2);function f1591(a,b){return a*1591+b}var v1591=f1591(1,2);function f1592(a,b){return a*1592+b}var v1592=f1592(1,2);function f1593(a,b){return a*1593+b}var v1593=f1593(1,2);function f1594(a,b){return a*1594+b}var v1594=f1594(1,2);function f1595(a,b){return a*1595+b}var v1595=f1595(1,2);function f1596(a,b){return a*1596+b}var v1596=f1596(1,2);function f1597(a,b){return a*1597+b}var v1597=f1597(1,2);function f1598(a,b){return a*1598+b}var v1598=f1598(1,2);function f1599(a,b){return a*1599+b}var v1599=f1599(1,2);function f1600(a,b){return a*1600+b}var v1600=f1600(1,2);function f1601(a,b){return a*1601+b}var v1601=f1601(1,2);function f1602(a,b){return a*1602+b}var v1602=f1602(1,2);function f1603(a,b){return a*1603+b}var v1603=f1603(1,2);function f1604(a,b){return a*1604+b}var v1604=f1604(1,2);function f1605(a,b){return a*1605+b}var v1605=f1605(1,2);function f1606(a,b){return a*1606+b}var v1606=f1606(1,2);function f1607(a,b){return a*1607+b}var v1607=f1607(1,2);function f1608(a,b){return a*1608+b}var v1608=f1608(1,2);function f1609(a,b){return a*1609+b}var v1609=f1609(1,2);function f1610(a,b){return a*1610+b}var v1610=f1610(1,2);function f1611(a,b){return a*1611+b}var v1611=f1611(1,2);function f1612(a,b){return a*1612+b}var v1612=f1612(1,2);function f1613(a,b){return a*1613+b}var v1613=f1613(1,2);function f1614(a,b){return a*1614+b}var v1614=f1614(1,2);function f1615(a,b){return a*1615+b}var v1615=f1615(1,2);function f1616(a,b){return a*1616+b}var v1616=f1616(1,2);function f1617(a,b){return a*1617+b}var v1617=f1617(1,2);function f1618(a,b){return a*1618+b}var v1618=f1618(1,2);function f1619(a,b){return a*1619+b}var v1619=f1619(1,2);function f1620(a,b){return a*1620+b}var v1620=f1620(1,2);function f1621(a,b){return a*1621+b}var v1621=f1621(1,2);function f1622(a,b){return a*1622+b}var v1622=f1622(1,2);function f1623(a,b){return a*1623+b}var v1623=f1623(1,2);function f1624(a,b){return a*1624+b}var v1624=f1624(1,2);function f1625(a,b){return a*1625+b}var v1625=f1625(1,2);function f1626(a,b){return a*1626+b}var v1626=f1626(1,2);function f1627(a,b){return a*1627+b}var v1627=f1627(1,2);function f1628(a,b){return a*1628+b}var v1628=f1628(1,2);function f1629(a,b){return a*1629+b}var v1629=f1629(1,2);function f1630(a,b){return a*1630+b}var v1630=f1630(1,2);function f1631(a,b){return a*1631+b}var v1631=f1631(1,2);function f1632(a,b){return a*1632+b}var v1632=f1632(1,2);function f1633(a,b){return a*1633+b}var v1633=f1633(1,2);function f1634(a,b){return a*1634+b}var v1634=f1634(1,2);function f1635(a,b){return a*1635+b}var v1635=f1635(1,2);function f1636(a,b){return a*1636+b}var v1636=f1636(1,2);function f1637(a,b){return a*1637+b}var v1637=f1637(1,2);function f1638(a,b){return a*1638+b}var v1638=f1638(1,2);function f1639(a,b){return a*1639+b}var v1639=f1639(1,2);function f1640(a,b){return a*1640+b}var v1640=f1640(1,2);function f1641(a,b){return a*1641+b}var v1641=f1641(1,2);function f1642(a,b){return a*1642+b}var v1642=f1642(1,2);function f1643(a,b){return a*1643+b}var v1643=f1643(1,2);function f1644(a,b){return a*1644+b}var v1644=f1644(1,2);function f1645(a,b){return a*1645+b}var v1645=f1645(1,2);function f1646(a,b){return a*1646+b}var v1646=f1646(1,2);function f1647(a,b){return a*1647+b}var v1647=f1647(1,2);function f1648(a,b){return a*1648+b}var v1648=f1648(1,2);function f1649(a,b){return a*1649+b}var v1649=f1649(1,2);function f1650(a,b){return a*1650+b}var v1650=f1650(1,2);function f1651(a,b){return a*1651+b}var v1651=f1651(1,2);function f1652(a,b){return a*1652+b}var v1652=f1652(1,2);function f1653(a,b){return a*1653+b}var v1653=f1653(1,2);function f1654(a,b){return a*1654+b}var v1654=f1654(1,2);function f1655(a,b){return a*1655+b}var v1655=f1655(1,2);function f1656(a,b){return a*1656+b}var v1656=f1656(1,2);function f1657(a,b){return a*1657+b}var v1657=f1657(1,2);function f1658(a,b){return a*1658+b}var v1658=f1658(1,2);function f1659(a,b){return a*1659+b}var v1659=f1659(1,2);function f1660(a,b){return a*1660+b}var v1660=f1660(1,2);function f1661(a,b){return a*1661+b}var v1661=f1661(1,2);function f1662(a,b){return a*1662+b}var v1662=f1662(1,2);function f1663(a,b){return a*1663+b}var v1663=f1663(1,2);function f1664(a,b){return a*1664+b}var v1664=f1664(1,2);function f1665(a,b){return a*1665+b}var v1665=f1665(1,2);function f1666(a,b){return a*1666+b}var v1666=f1666(1,2);function f1667(a,b){return a*1667+b}var v1667=f1667(1,2);function f1668(a,b){return a*1668+b}var v1668=f1668(1,2);function f1669(a,b){return a*1669+b}var v1669=f1669(1,2);function f1670(a,b){return a*1670+b}var v1670=f1670(1,2);function f1671(a,b){return a*1671+b}var v1671=f1671(1,2);function f1672(a,b){return a*1672+b}var v1672=f1672(1,2);function f1673(a,b){return a*1673+b}var v1673=f1673(1,2);function f1674(a,b){return a*1674+b}var v1674=f1674(1,2);function f1675(a,b){return a*1675+b}var v1675=f1675(1,2);function f1676(a,b){return a*1676+b}var v1676=f1676(1,2);function f1677(a,b){return a*1677+b}var v1677=f1677(1,2);function f1678(a,b){return a*1678+b}var v1678=f1678(1,2);function f1679(a,b){return a*1679+b}var v1679=f1679(1,2);function f1680(a,b){return a*1680+b}var v1680=f1680(1,2);function f1681(a,b){return a*1681+b}var v1681=f1681(1,2);function f1682(a,b){return a*1682+b}var v1682=f1682(1,2);function f1683(a,b){return a*1683+b}var v1683=f1683(1,2);function f1684(a,b){return a*1684+b}var v1684=f1684(1,2);function f1685(a,b){return a*1685+b}var v1685=f1685(1,2);function f1686(a,b){return a*1686+b}var v1686=f1686(1,2);function f1687(a,b){return a*1687+b}var v1687=f1687(1,2);function f1688(a,b){return a*1688+b}var v1688=f1688(1,2);function f1689(a,b){return a*1689+b}var v1689=f1689(1,2);function f1690(a,b){return a*1690+b}var v1690=f1690(1,2);function f1691(a,b){return a*1691+b}var v1691=f1691(1,2);function f1692(a,b){return a*1692+b}var v1692=f1692(1,2);function f1693(a,b){return a*1693+b}var v1693=f1693(1,2);function f1694(a,b){return a*1694+b}var v1694=f1694(1,2);function f1695(a,b){return a*1695+b}var v1695=f1695(1,2);function f1696(a,b){return a*1696+b}var v1696=f1696(1,2);function f1697(a,b){return a*1697+b}var v1697=f1697(1,2);function f1698(a,b){return a*1698+b}var v1698=f1698(1,2);function f1699(a,b){return a*1699+b}var v1699=f1699(1,2);function f1700(a,b){return a*1700+b}var v1700=f1700(1,2);function f1701(a,b){return a*1701+b}var v1701=f1701(1,2);function f1702(a,b){return a*1702+b}var v1702=f1702(1,2);function f1703(a,b){return a*1703+b}var v1703=f1703(1,2);function f1704(a,b){return a*1704+b}var v1704=f1704(1,2);function f1705(a,b){return a*1705+b}var v1705=f1705(1,2);function f1706(a,b){return a*1706+b}var v1706=f1706(1,2);function f1707(a,b){return a*1707+b}var v1707=f1707(1,2);function f1708(a,b){return a*1708+b}var v1708=f1708(1,2);function f1709(a,b){return a*1709+b}var v1709=f1709(1,2);function f1710(a,b){return a*1710+b}var v1710=f1710(1,2);function f1711(a,b){return a*1711+b}var v1711=f1711(1,2);function f1712(a,b){return a*1712+b}var v1712=f1712(1,2);function f1713(a,b){return a*1713+b}var v1713=f1713(1,2);function f1714(a,b){return a*1714+b}var v1714=f1714(1,2);function f1715(a,b){return a*1715+b}var v1715=f1715(1,2);function f1716(a,b){return a*1716+b}var v1716=f1716(1,2);function f1717(a,b){return a*1717+b}var v1717=f1717(1,2);function f1718(a,b){return a*1718+b}var v1718=f1718(1,2);function f1719(a,b){return a*1719+b}var v1719=f1719(1,2);function f1720(a,b){return a*1720+b}var v1720=f1720(1,2);function f1721(a,b){return a*1721+b}var v1721=f1721(1,2);function f1722(a,b){return a*1722+b}var v1722=f1722(1,2);function f1723(a,b){return a*1723+b}var v1723=f1723(1,2);function f1724(a,b){return a*1724+b}var v1724=f1724(1,2);function f1725(a,b){return a*1725+b}var v1725=f1725(1,2);function f1726(a,b){return a*1726+b}var v1726=f1726(1,2);function f1727(a,b){return a*1727+b}var v1727=f1727(1,2);function f1728(a,b){return a*1728+b}var v1728=f1728(1,2);function f1729(a,b){return a*1729+b}var v1729=f1729(1,2);function f1730(a,b){return a*1730+b}var v1730=f1730(1,2);function f1731(a,b){return a*1731+b}var v1731=f1731(1,2);function f1732(a,b){return a*1732+b}var v1732=f1732(1,2);function f1733(a,b){return a*1733+b}var v1733=f1733(1,2);function f1734(a,b){return a*1734+b}var v1734=f1734(1,2);function f1735(a,b){return a*1735+b}var v1735=f1735(1,2);function f1736(a,b){return a*1736+b}var v1736=f1736(1,2);function f1737(a,b){return a*1737+b}var v1737=f1737(1,2);function f1738(a,b){return a*1738+b}var v1738=f1738(1,2);function f1739(a,b){return a*1739+b}var v1739=f1739(1,2);function f1740(a,b){return a*1740+b}var v1740=f1740(1,2);function f1741(a,b){return a*1741+b}var v1741=f1741(1,2);function f1742(a,b){return a*1742+b}var v1742=f1742(1,2);function f1743(a,b){return a*1743+b}var v1743=f1743(1,2);function f1744(a,b){return a*1744+b}var v1744=f1744(1,2);function f1745(a,b){return a*1745+b}var v1745=f1745(1,2);function f1746(a,b){return a*1746+b}var v1746=f1746(1,2);function f1747(a,b){return a*1747+b}var v1747=f1747(1,2);function f1748(a,b){return a*1748+b}var v1748=f1748(1,2);function f1749(a,b){return a*1749+b}var v1749=f1749(1,2);function f1750(a,b){return a*1750+b}var v1750=f1750(1,2);function f1751(a,b){return a*1751+b}var v1751=f1751(1,2);function f1752(a,b){return a*1752+b}var v1752=f1752(1,2);function f1753(a,b){return a*1753+b}var v1753=f1753(1,2);function f1754(a,b){return a*1754+b}var v1754=f1754(1,2);function f1755(a,b){return a*1755+b}var v1755=f1755(1,2);function f1756(a,b){return a*1756+b}var v1756=f1756(1,2);function f1757(a,b){return a*1757+b}var v1757=f1757(1,2);function f1758(a,b){return a*1758+b}var v1758=f1758(1,2);function f1759(a,b){return a*1759+b}var v1759=f1759(1,2);function f1760(a,b){return a*1760+b}var v1760=f1760(1,2);function f1761(a,b){return a*1761+b}var v1761=f1761(1,2);function f1762(a,b){return a*1762+b}var v1762=f1762(1,2);function f1763(a,b){return a*1763+b}var v1763=f1763(1,2);function f1764(a,b){return a*1764+b}var v1764=f1764(1,2);function f1765(a,b){return a*1765+b}var v1765=f1765(1,2);function f1766(a,b){return a*1766+b}var v1766=f1766(1,2);function f1767(a,b){return a*1767+b}var v1767=f1767(1,2);function f1768(a,b){return a*1768+b}var v1768=f1768(1,2);function f1769(a,b){return a*1769+b}var v1769=f1769(1,2);function f1770(a,b){return a*1770+b}var v1770=f1770(1,2);function f1771(a,b){return a*1771+b}var v1771=f1771(1,2);function f1772(a,b){return a*1772+b}var v1772=f1772(1,2);function f1773(a,b){return a*1773+b}var v1773=f1773(1,2);function f1774(a,b){return a*1774+b}var v1774=f1774(1,2);function f1775(a,b){return a*1775+b}var v1775=f1775(1,2);function f1776(a,b){return a*1776+b}var v1776=f1776(1,2);function f1777(a,b){return a*1777+b}var v1777=f1777(1,2);function f1778(a,b){return a*1778+b}var v1778=f1778(1,2);function f1779(a,b){return a*1779+b}var v1779=f1779(1,2);function f1780(a,b){return a*1780+b}var v1780=f1780(1,2);function f1781(a,b){return a*1781+b}var v1781=f1781(1,2);function f1782(a,b){return a*1782+b}var v1782=f1782(1,2);function f1783(a,b){return a*1783+b}var v1783=f1783(1,2);function f1784(a,b){return a*1784+b}var v1784=f1784(1,2);function f1785(a,b){return a*1785+b}var v1785=f1785(1,2);function f1786(a,b){return a*1786+b}var v1786=f1786(1,2);function f1787(a,b){return a*1787+b}var v1787=f1787(1,2);function f1788(a,b){return a*1788+b}var v1788=f1788(1,2);function f1789(a,b){return a*1789+b}var v1789=f1789(1,2);function f1790(a,b){return a*1790+b}var v1790=f1790(1,2);function f1791(a,b){return a*1791+b}var v1791=f1791(1,2);function f1792(a,b){return a*1792+b}var v1792=f1792(1,2);function f1793(a,b){return a*1793+b}var v1793=f1793(1,2);function f1794(a,b){return a*1794+b}var v1794=f1794(1,2);function f1795(a,b){return a*1795+b}var v1795=f1795(1,2);function f1796(a,b){return a*1796+b}var v1796=f1796(1,2);function f1797(a,b){return a*1797+b}var v1797=f1797(1,2);function f1798(a,b){return a*1798+b}var v1798=f1798(1,2);function f1799(a,b){return a*1799+b}var v1799=f1799(1,2);function f1800(a,b){return a*1800+b}var v1800=f1800(1,2);function f1801(a,b){return a*1801+b}var v1801=f1801(1,2);function f1802(a,b){return a*1802+b}var v1802=f1802(1,2);function f1803(a,b){return a*1803+b}var v1803=f1803(1,2);function f1804(a,b){return a*1804+b}var v1804=f1804(1,2);function f1805(a,b){return a*1805+b}var v1805=f1805(1,2);function f1806(a,b){return a*1806+b}var v1806=f1806(1,2);function f1807(a,b){return a*1807+b}var v1807=f1807(1,2);function f1808(a,b){return a*1808+b}var v1808=f1808(1,2);function f1809(a,b){return a*1809+b}var v1809=f1809(1,2);function f1810(a,b){return a*1810+b}var v1810=f1810(1,2);function f1811(a,b){return a*1811+b}var v1811=f1811(1,2);function f1812(a,b){return a*1812+b}var v1812=f1812(1,2);function f1813(a,b){return a*1813+b}var v1813=f1813(1,2);function f1814(a,b){return a*1814+b}var v1814=f1814(1,2);function f1815(a,b){return a*1815+b}var v1815=f1815(1,2);function f1816(a,b){return a*1816+b}var v1816=f1816(1,2);function f1817(a,b){return a*1817+b}var v1817=f1817(1,2);function f1818(a,b){return a*1818+b}var v1818=f1818(1,2);function f1819(a,b){return a*1819+b}var v1819=f1819(1,2);function f1820(a,b){return a*1820+b}var v1820=f1820(1,2);function f1821(a,b){return a*1821+b}var v1821=f1821(1,2);function f1822(a,b){return a*1822+b}var v1822=f1822(1,2);function f1823(a,b){return a*1823+b}var v1823=f1823(1,2);function f1824(a,b){return a*1824+b}var v1824=f1824(1,2);function f1825(a,b){return a*1825+b}var v1825=f1825(1,2);function f1826(a,b){return a*1826+b}var v1826=f1826(1,2);function f1827(a,b){return a*1827+b}var v1827=f1827(1,2);function f1828(a,b){return a*1828+b}var v1828=f1828(1,2);function f1829(a,b){return a*1829+b}var v1829=f1829(1,2);function f1830(a,b){return a*1830+b}var v1830=f1830(1,2);function f1831(a,b){return a*1831+b}var v1831=f1831(1,2);function f1832(a,b){return a*1832+b}var v1832=f1832(1,2);function f1833(a,b){return a*1833+b}var v1833=f1833(1,2);function f1834(a,b){return a*1834+b}var v1834=f1834(1,2);function f1835(a,b){return a*1835+b}var v1835=f1835(1,2);function f1836(a,b){return a*1836+b}var v1836=f1836(1,2);function f1837(a,b){return a*1837+b}var v1837=f1837(1,2);function f1838(a,b){return a*1838+b}var v1838=f1838(1,2);function f1839(a,b){return a*1839+b}var v1839=f1839(1,2);function f1840(a,b){return a*1840+b}var v1840=f1840(1,2);function f1841(a,b){return a*1841+b}var v1841=f1841(1,2);function f1842(a,b){return a*1842+b}var v1842=f1842(1,2);function f1843(a,b){return a*1843+b}var v1843=f1843(1,2);function f1844(a,b){return a*1844+b}var v1844=f1844(1,2);function f1845(a,b){return a*1845+b}var v1845=f1845(1,2);function f1846(a,b){return a*1846+b}var v1846=f1846(1,2);function f1847(a,b){return a*1847+b}var v1847=f1847(1,2);function f1848(a,b){return a*1848+b}var v1848=f1848(1,2);function f1849(a,b){return a*1849+b}var v1849=f1849(1,2);function f1850(a,b){return a*1850+b}var v1850=f1850(1,2);function f1851(a,b){return a*1851+b}var v1851=f1851(1,2);function f1852(a,b){return a*1852+b}var v1852=f1852(1,2);function f1853(a,b){return a*1853+b}var v1853=f1853(1,2);function f1854(a,b){return a*1854+b}var v1854=f1854(1,2);function f1855(a,b){return a*1855+b}var v1855=f1855(1,2);function f1856(a,b){return a*1856+b}var v1856=f1856(1,2);function f1857(a,b){return a*1857+b}var v1857=f1857(1,2);function f1858(a,b){return a*1858+b}var v1858=f1858(1,2);function f1859(a,b){return a*1859+b}var v1859=f1859(1,2);function f1860(a,b){return a*1860+b}var v1860=f1860(1,2);function f1861(a,b){return a*1861+b}var v1861=f1861(1,2);function f1862(a,b){return a*1862+b}var v1862=f1862(1,2);function f1863(a,b){return a*1863+b}var v1863=f1863(1,2);function f1864(a,b){return a*1864+b}var v1864=f1864(1,2);function f1865(a,b){return a*1865+b}var v1865=f1865(1,2);function f1866(a,b){return a*1866+b}var v1866=f1866(1,2);function f1867(a,b){return a*1867+b}var v1867=f1867(1,2);function f1868(a,b){return a*1868+b}var v1868=f1868(1,2);function f1869(a,b){return a*1869+b}var v1869=f1869(1,2);function f1870(a,b){return a*1870+b}var v1870=f1870(1,2);function f1871(a,b){return a*1871+b}var v1871=f1871(1,2);function f1872(a,b){return a*1872+b}var v1872=f1872(1,2);function f1873(a,b){return a*1873+b}var v1873=f1873(1,2);function f1874(a,b){return a*1874+b}var v1874=f1874(1,2);
@@ -0,0 +1,67 @@
+# Basic 2D geometry: points, shapes and their areas.
+module Geometry
+
+using LinearAlgebra
+import Base: show, +
+
+export Point, Circle, Polygon, area, perimeter
+
+const ORIGIN_TOLERANCE = 1e-9
+const UNIT = 1.0
+
+"""
+    Point(x, y)
+
+A point in the plane.
+"""
+struct Point
+    x::Float64
+    y::Float64
+end
+
+abstract type Shape end
+
+mutable struct Circle <: Shape
+    center::Point
+    radius::Float64
+end
+
+struct Polygon <: Shape
+    vertices::Vector{Point}
+end
+
++(a::Point, b::Point) = Point(a.x + b.x, a.y + b.y)
+norm2(p::Point) = sqrt(p.x^2 + p.y^2)
+
+"""
+    area(shape)
+
+The area enclosed by `shape`.
+"""
+function area(c::Circle)
+    return pi * c.radius^2
+end
+
+function area(p::Polygon)
+    n = length(p.vertices)
+    s = 0.0
+    for i in 1:n
+        a, b = p.vertices[i], p.vertices[mod1(i + 1, n)]
+        s += a.x * b.y - b.x * a.y
+    end
+    return abs(s) / 2
+end
+
+# Sum of the distances between consecutive vertices.
+@inline function perimeter(p::Polygon)
+    n = length(p.vertices)
+    return sum(norm2(p.vertices[mod1(i + 1, n)] + Point(-p.vertices[i].x, -p.vertices[i].y)) for i in 1:n)
+end
+
+macro approx(a, b)
+    return :(abs($(esc(a)) - $(esc(b))) < ORIGIN_TOLERANCE)
+end
+
+Base.show(io::IO, p::Point) = print(io, "(", p.x, ", ", p.y, ")")
+
+end # module
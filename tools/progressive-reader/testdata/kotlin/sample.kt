@@ -0,0 +1,47 @@
+package com.example.accounts
+
+import java.security.MessageDigest
+import java.util.UUID
+
+data class User(
+    val id: String = UUID.randomUUID().toString(),
+    val email: String,
+    val name: String,
+    val passwordHash: String,
+)
+
+interface UserRepository {
+    fun findById(id: String): User?
+    fun findByEmail(email: String): User?
+    fun save(user: User)
+}
+
+object PasswordHasher {
+    private const val ALGORITHM = "SHA-256"
+
+    fun hash(password: String): String {
+        val digest = MessageDigest.getInstance(ALGORITHM)
+        return digest.digest(password.toByteArray()).joinToString("") { "%02x".format(it) }
+    }
+}
+
+class AuthService(private val users: UserRepository) {
+    private val failedAttempts = mutableMapOf<String, Int>()
+
+    fun authenticate(email: String, password: String): String? {
+        val user = users.findByEmail(email) ?: return null
+        if (PasswordHasher.hash(password) != user.passwordHash) {
+            failedAttempts[email] = (failedAttempts[email] ?: 0) + 1
+            return null
+        }
+        failedAttempts.remove(email)
+        return generateToken(user.id)
+    }
+
+    private fun generateToken(userId: String): String =
+        "$userId.${UUID.randomUUID()}"
+}
+
+fun main() {
+    println("accounts service ready")
+}
@@ -0,0 +1,41 @@
+-- Inventory module for the game
+local M = {}
+
+local MAX_STACK = 99
+
+local function clamp(n, lo, hi)
+  if n < lo then return lo end
+  if n > hi then return hi end
+  return n
+end
+
+function M.new(owner)
+  local self = setmetatable({}, { __index = M })
+  self.owner = owner
+  self.items = {}
+  return self
+end
+
+function M:add(item, count)
+  local current = self.items[item] or 0
+  self.items[item] = clamp(current + count, 0, MAX_STACK)
+  return self.items[item]
+end
+
+function M:remove(item, count)
+  return self:add(item, -count)
+end
+
+M.on_change = function(item, count)
+  print(item, count)
+end
+
+function describe(inventory)
+  local parts = {}
+  for item, count in pairs(inventory.items) do
+    table.insert(parts, item .. " x" .. count)
+  end
+  return table.concat(parts, ", ")
+end
+
+return M
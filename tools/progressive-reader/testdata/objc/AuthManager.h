@@ -0,0 +1,42 @@
+//
+//  AuthManager.h
+//  Sample
+//
+
+#import <Foundation/Foundation.h>
+
+@class User;
+
+NS_ASSUME_NONNULL_BEGIN
+
+typedef NS_ENUM(NSInteger, AuthState) {
+    AuthStateSignedOut,
+    AuthStateSigningIn,
+    AuthStateSignedIn,
+};
+
+/// Notified when the signed-in user changes.
+@protocol AuthManagerDelegate <NSObject>
+- (void)authManager:(id)manager didChangeState:(AuthState)state;
+@optional
+- (void)authManagerDidFail:(NSError *)error;
+@end
+
+/// Signs users in and keeps their session alive.
+@interface AuthManager : NSObject
+
+@property (nonatomic, weak, nullable) id<AuthManagerDelegate> delegate;
+@property (nonatomic, readonly) AuthState state;
+@property (nonatomic, strong, readonly, nullable) User *currentUser;
+
++ (instancetype)sharedManager;
+- (void)signInWithEmail:(NSString *)email password:(NSString *)password;
+- (void)signOut;
+
+@end
+
+@interface AuthManager (Testing)
+- (void)resetForTesting;
+@end
+
+NS_ASSUME_NONNULL_END
@@ -0,0 +1,76 @@
+#import "AuthManager.h"
+#import "User.h"
+
+static NSString *const kSessionKey = @"session";
+static const NSTimeInterval kRefreshInterval = 300;
+
+// Returns YES if the address has an @ with text on both sides.
+static BOOL IsValidEmail(NSString *email) {
+    NSRange at = [email rangeOfString:@"@"];
+    return at.location != NSNotFound && at.location > 0 && at.location < email.length - 1;
+}
+
+@interface AuthManager ()
+@property (nonatomic, readwrite) AuthState state;
+@property (nonatomic, strong) NSTimer *refreshTimer;
+@end
+
+@implementation AuthManager
+
+#pragma mark - Lifecycle
+
++ (instancetype)sharedManager {
+    static AuthManager *shared;
+    static dispatch_once_t once;
+    dispatch_once(&once, ^{
+        shared = [[AuthManager alloc] init];
+    });
+    return shared;
+}
+
+- (instancetype)init {
+    if ((self = [super init])) {
+        _state = AuthStateSignedOut;
+    }
+    return self;
+}
+
+#pragma mark - Signing in
+
+- (void)signInWithEmail:(NSString *)email password:(NSString *)password {
+    if (!IsValidEmail(email)) {
+        [self.delegate authManagerDidFail:[NSError errorWithDomain:@"Auth" code:1 userInfo:nil]];
+        return;
+    }
+    self.state = AuthStateSigningIn;
+    [self.delegate authManager:self didChangeState:self.state];
+    [[NSUserDefaults standardUserDefaults] setObject:email forKey:kSessionKey];
+    self.state = AuthStateSignedIn;
+    [self scheduleRefresh];
+}
+
+- (void)signOut {
+    [self.refreshTimer invalidate];
+    [[NSUserDefaults standardUserDefaults] removeObjectForKey:kSessionKey];
+    self.state = AuthStateSignedOut;
+}
+
+#pragma mark - Private
+
+- (void)scheduleRefresh {
+    self.refreshTimer = [NSTimer scheduledTimerWithTimeInterval:kRefreshInterval
+                                                         target:self
+                                                       selector:@selector(refresh)
+                                                       userInfo:nil
+                                                        repeats:YES];
+}
+
+@end
+
+@implementation AuthManager (Testing)
+
+- (void)resetForTesting {
+    [self signOut];
+}
+
+@end
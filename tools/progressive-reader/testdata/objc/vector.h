@@ -0,0 +1,12 @@
+#ifndef VECTOR_H
+#define VECTOR_H
+
+#include <stddef.h>
+
+typedef struct {
+    double x, y;
+} vec2;
+
+vec2 vec2_add(vec2 a, vec2 b);
+
+#endif
@@ -0,0 +1,73 @@
+#!/usr/bin/perl
+# Notifier.pm - queue and deliver user notifications
+use strict;
+use warnings;
+
+package App::Notifier;
+
+use Carp qw(croak);
+use List::Util qw(first);
+
+our $VERSION = '1.04';
+my %channels = (email => 1, sms => 1, push => 1);
+
+=head2 new
+
+Creates a notifier delivering through the given transport.
+
+=cut
+
+sub new {
+    my ($class, %args) = @_;
+    croak "transport required" unless $args{transport};
+    my $self = bless {
+        transport => $args{transport},
+        queue     => [],
+        retries   => $args{retries} // 3,
+    }, $class;
+    return $self;
+}
+
+# Adds a notification to the queue after checking its channel.
+sub enqueue {
+    my ($self, $user, $channel, $message) = @_;
+    croak "unknown channel $channel" unless $channels{$channel};
+    push @{ $self->{queue} }, {
+        user    => $user,
+        channel => $channel,
+        message => $message,
+        tries   => 0,
+    };
+    return scalar @{ $self->{queue} };
+}
+
+# Sends everything queued, keeping failures for the next flush.
+sub flush {
+    my ($self) = @_;
+    my @failed;
+    for my $note (@{ $self->{queue} }) {
+        my $ok = eval { $self->{transport}->send($note); 1 };
+        next if $ok;
+        $note->{tries}++;
+        push @failed, $note if $note->{tries} < $self->{retries};
+    }
+    $self->{queue} = \@failed;
+    return scalar @failed;
+}
+
+sub pending { scalar @{ $_[0]{queue} } }
+
+package App::Notifier::NullTransport;
+
+sub new  { bless {}, shift }
+sub send { 1 }
+
+1;
+
+__END__
+
+=head1 NAME
+
+App::Notifier - queue and deliver user notifications
+
+=cut
@@ -0,0 +1,56 @@
+<!DOCTYPE html>
+<html>
+<head>
+    <title>Account</title>
+</head>
+<body>
+<?php
+declare(strict_types=1);
+
+namespace App\Accounts;
+
+interface UserRepository
+{
+    public function findById(string $id): ?User;
+
+    public function save(User $user): void;
+}
+
+trait LogsActivity
+{
+    protected function log(string $message): void
+    {
+        error_log(sprintf('[%s] %s', static::class, $message));
+    }
+}
+
+final class AuthService
+{
+    use LogsActivity;
+
+    public function __construct(private UserRepository $users)
+    {
+    }
+
+    public function authenticate(string $id, string $password): bool
+    {
+        $user = $this->users->findById($id);
+        if ($user === null) {
+            $this->log("unknown user {$id}");
+            return false;
+        }
+
+        return password_verify($password, $user->passwordHash);
+    }
+}
+
+function render_greeting(string $name): string
+{
+    return '<h1>Hello, ' . htmlspecialchars($name) . '</h1>';
+}
+?>
+<footer>
+    <p>&copy; Sample Co.</p>
+</footer>
+</body>
+</html>
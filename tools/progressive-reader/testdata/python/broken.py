@@ -0,0 +1,24 @@
+"""Module with a syntax error in the middle."""
+
+
+def load(path):
+    with open(path) as f:
+        return f.read()
+
+
+class Config:
+    def __init__(self, values):
+        self.values = values
+
+    def get(self, key, default=None):
+        return self.values.get(key, default)
+
+
+def broken(items:
+    for item in items
+        print(item
+
+
+def save(path, data):
+    with open(path, "w") as f:
+        f.write(data)
@@ -0,0 +1,3 @@
+# 🍮 Crème brûlée façade 🎉: naïve résumé parsing for café menus, piñatas and jalapeños
+def parse_menu(text):
+    return [line.strip() for line in text.splitlines() if line.strip()]
@@ -0,0 +1,62 @@
+require 'securerandom'
+require 'digest'
+
+module Accounts
+  class User
+    attr_reader :id, :email, :name
+
+    def initialize(email:, name:)
+      @id = SecureRandom.uuid
+      @email = email
+      @name = name
+    end
+
+    def to_h
+      { id: id, email: email, name: name }
+    end
+  end
+
+  class UserRepository
+    def self.connect(url)
+      new(Database.connect(url))
+    end
+
+    def initialize(db)
+      @db = db
+    end
+
+    def find_by_id(id)
+      row = @db.query_one('SELECT * FROM users WHERE id = ?', id)
+      row && User.new(email: row['email'], name: row['name'])
+    end
+
+    def create(user)
+      @db.execute('INSERT INTO users (id, email, name) VALUES (?, ?, ?)',
+                  user.id, user.email, user.name)
+      user
+    end
+  end
+
+  class AuthService
+    def initialize(repository)
+      @repository = repository
+    end
+
+    def authenticate(email, password)
+      user = @repository.find_by_email(email)
+      return nil unless user && valid_password?(password, user.password_hash)
+
+      generate_token(user.id)
+    end
+
+    private
+
+    def valid_password?(password, hash)
+      Digest::SHA256.hexdigest(password) == hash
+    end
+
+    def generate_token(user_id)
+      "#{user_id}.#{SecureRandom.hex(16)}"
+    end
+  end
+end
@@ -0,0 +1,78 @@
+package com.example.inventory
+
+import scala.collection.mutable
+import scala.util.{Failure, Success, Try}
+
+/** A stock-keeping unit and how many are on hand. */
+case class Item(sku: String, name: String, quantity: Int, price: BigDecimal)
+
+/** Where inventory is kept between runs. */
+trait Store {
+  def load(): Seq[Item]
+  def save(items: Seq[Item]): Unit
+}
+
+sealed trait Adjustment
+case class Received(sku: String, amount: Int) extends Adjustment
+case class Sold(sku: String, amount: Int) extends Adjustment
+
+val DefaultReorderLevel = 5
+
+/** Tracks stock levels and applies adjustments to them. */
+class Inventory(store: Store, reorderLevel: Int = DefaultReorderLevel) {
+  private val items = mutable.Map.empty[String, Item]
+
+  /** Loads the stored items, replacing any held in memory. */
+  def reload(): Unit = {
+    items.clear()
+    store.load().foreach(item => items(item.sku) = item)
+  }
+
+  /** Applies an adjustment, failing if it would leave negative stock. */
+  def apply(adjustment: Adjustment): Try[Item] = adjustment match {
+    case Received(sku, amount) =>
+      update(sku)(item => item.copy(quantity = item.quantity + amount))
+    case Sold(sku, amount) =>
+      update(sku) { item =>
+        if (item.quantity < amount)
+          throw new IllegalStateException(s"only ${item.quantity} of $sku left")
+        item.copy(quantity = item.quantity - amount)
+      }
+  }
+
+  /** Items whose stock has fallen to the reorder level or below. */
+  def lowStock: Seq[Item] =
+    items.values.filter(_.quantity <= reorderLevel).toSeq.sortBy(_.sku)
+
+  /** The total value of everything in stock. */
+  def value: BigDecimal =
+    items.values.map(item => item.price * item.quantity).sum
+
+  private def update(sku: String)(f: Item => Item): Try[Item] =
+    items.get(sku) match {
+      case Some(item) =>
+        Try(f(item)).map { updated =>
+          items(sku) = updated
+          store.save(items.values.toSeq)
+          updated
+        }
+      case None => Failure(new NoSuchElementException(s"unknown sku $sku"))
+    }
+}
+
+/** Builds inventories backed by an in-memory store. */
+object Inventory {
+  def inMemory(seed: Item*): Inventory = {
+    val store = new Store {
+      private var saved: Seq[Item] = seed
+      def load(): Seq[Item] = saved
+      def save(items: Seq[Item]): Unit = saved = items
+    }
+    val inventory = new Inventory(store)
+    inventory.reload()
+    inventory
+  }
+
+  def report(inventory: Inventory): String =
+    inventory.lowStock.map(item => s"${item.sku}: ${item.quantity}").mkString("\n")
+}
@@ -0,0 +1,29 @@
+-- Migration 0042: accounts and sessions
+
+CREATE TABLE accounts (
+    id UUID PRIMARY KEY,
+    email TEXT NOT NULL,
+    name TEXT NOT NULL,
+    password_hash TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX idx_accounts_email ON accounts (email);
+
+CREATE TABLE sessions (
+    id UUID PRIMARY KEY,
+    account_id UUID NOT NULL REFERENCES accounts (id) ON DELETE CASCADE,
+    token TEXT NOT NULL,
+    expires_at TIMESTAMP NOT NULL
+);
+
+ALTER TABLE accounts ADD COLUMN last_login_at TIMESTAMP;
+
+INSERT INTO accounts (id, email, name, password_hash)
+VALUES
+    ('00000000-0000-0000-0000-000000000001', 'admin@example.com', 'Admin', 'x'),
+    ('00000000-0000-0000-0000-000000000002', 'ops@example.com', 'Ops', 'y');
+
+UPDATE accounts SET name = 'Administrator' WHERE email = 'admin@example.com';
+
+DELETE FROM sessions WHERE expires_at < CURRENT_TIMESTAMP;
@@ -0,0 +1,56 @@
+import Foundation
+import CryptoKit
+
+struct User: Codable {
+    let id: UUID
+    let email: String
+    let name: String
+    let passwordHash: String
+}
+
+enum AuthError: Error {
+    case unknownUser
+    case invalidPassword
+    case locked(until: Date)
+}
+
+protocol UserRepository {
+    func find(byEmail email: String) async throws -> User?
+    func save(_ user: User) async throws
+}
+
+final class AuthService {
+    private let users: UserRepository
+    private var failedAttempts: [String: Int] = [:]
+
+    init(users: UserRepository) {
+        self.users = users
+    }
+
+    func authenticate(email: String, password: String) async throws -> String {
+        guard let user = try await users.find(byEmail: email) else {
+            throw AuthError.unknownUser
+        }
+        guard hash(password) == user.passwordHash else {
+            failedAttempts[email, default: 0] += 1
+            throw AuthError.invalidPassword
+        }
+        failedAttempts[email] = nil
+        return "\(user.id).\(UUID().uuidString)"
+    }
+}
+
+extension AuthService {
+    func hash(_ password: String) -> String {
+        let digest = SHA256.hash(data: Data(password.utf8))
+        return digest.map { String(format: "%02x", $0) }.joined()
+    }
+
+    func resetAttempts(for email: String) {
+        failedAttempts[email] = nil
+    }
+}
+
+func makeDefaultService(repository: UserRepository) -> AuthService {
+    AuthService(users: repository)
+}
@@ -0,0 +1,64 @@
+import React, { useState } from "react";
+import { Chart } from "./Chart";
+
+interface DashboardProps {
+  title: string;
+  orders: Order[];
+  onRefresh: () => void;
+}
+
+interface Order {
+  id: string;
+  customer: string;
+  total: number;
+}
+
+export function Dashboard({ title, orders, onRefresh }: DashboardProps) {
+  const [filter, setFilter] = useState("");
+  const visible = orders.filter((o) => o.customer.includes(filter));
+  const revenue = visible.reduce((sum, o) => sum + o.total, 0);
+
+  return (
+    <div className="dashboard">
+      <header className="dashboard-header">
+        <h1>{title}</h1>
+        <p className="subtitle">
+          {visible.length} of {orders.length} orders, {revenue.toFixed(2)} in revenue
+        </p>
+        <button type="button" onClick={onRefresh}>
+          Refresh
+        </button>
+      </header>
+      <section className="filters">
+        <label htmlFor="customer-filter">Customer</label>
+        <input
+          id="customer-filter"
+          value={filter}
+          onChange={(e) => setFilter(e.target.value)}
+          placeholder="Filter by customer name"
+        />
+      </section>
+      <table className="orders">
+        <thead>
+          <tr>
+            <th>Order</th>
+            <th>Customer</th>
+            <th>Total</th>
+          </tr>
+        </thead>
+        <tbody>
+          {visible.map((o) => (
+            <tr key={o.id}>
+              <td>{o.id}</td>
+              <td>{o.customer}</td>
+              <td>{o.total.toFixed(2)}</td>
+            </tr>
+          ))}
+        </tbody>
+      </table>
+      <Chart data={visible.map((o) => o.total)} height={240} />
+    </div>
+  );
+}
+
+export const EmptyState = () => <p className="empty">No orders yet.</p>;
@@ -0,0 +1,28 @@
+import { Readable } from "stream";
+
+const DEFAULT_ENCODING = "utf-8";
+
+export interface ParseOptions {
+  strict: boolean;
+}
+
+function parse(input: string): Record<string, unknown>;
+function parse(input: Buffer, encoding?: BufferEncoding): Record<string, unknown>;
+function parse(input: Readable): Promise<Record<string, unknown>>;
+function parse(input: string | Buffer | Readable, encoding: BufferEncoding = DEFAULT_ENCODING): unknown {
+  if (input instanceof Readable) {
+    return readAll(input).then((text) => JSON.parse(text));
+  }
+  const text = typeof input === "string" ? input : input.toString(encoding);
+  return JSON.parse(text);
+}
+
+async function readAll(stream: Readable): Promise<string> {
+  const parts: Buffer[] = [];
+  for await (const part of stream) {
+    parts.push(Buffer.from(part));
+  }
+  return Buffer.concat(parts).toString(DEFAULT_ENCODING);
+}
+
+export { parse, readAll };
@@ -0,0 +1,41 @@
+;; counter.wat - a bump allocator and a counter exported to the host
+(module $counter
+  (type $log_t (func (param i32)))
+  (type $bin_t (func (param i32 i32) (result i32)))
+
+  (import "env" "log" (func $log (type $log_t)))
+  (import "env" "abort" (func $abort))
+
+  ;; One page of linear memory, shared with the host.
+  (memory $mem 1)
+  (global $count (mut i32) (i32.const 0))
+  (global $heap_top (mut i32) (i32.const 1024))
+
+  ;; Adds one to the counter and returns the new value.
+  (func $increment (export "increment") (result i32)
+    (global.set $count
+      (i32.add (global.get $count) (i32.const 1)))
+    (call $log (global.get $count))
+    (global.get $count))
+
+  (; Reserves n bytes from the heap and returns their address.
+     Traps through $abort when the page is full. ;)
+  (func $alloc (param $n i32) (result i32)
+    (local $addr i32)
+    (local.set $addr (global.get $heap_top))
+    (if (i32.gt_u
+          (i32.add (local.get $addr) (local.get $n))
+          (i32.const 65536))
+      (then (call $abort)))
+    (global.set $heap_top (i32.add (local.get $addr) (local.get $n)))
+    (local.get $addr))
+
+  (func $add (type $bin_t)
+    (i32.add (local.get 0) (local.get 1)))
+
+  (export "alloc" (func $alloc))
+  (export "add" (func $add))
+  (export "memory" (memory $mem))
+
+  (data (i32.const 0) "counter v1 (c) \"wasm\"")
+)
@@ -0,0 +1,68 @@
+//! A small ring buffer used by the scheduler.
+
+const std = @import("std");
+const mem = std.mem;
+const Allocator = std.mem.Allocator;
+
+pub const default_capacity = 64;
+
+/// Errors returned when the buffer cannot accept or yield items.
+pub const BufferError = error{
+    Full,
+    Empty,
+};
+
+/// Fixed-size FIFO queue backed by a caller-provided slice.
+pub const RingBuffer = struct {
+    items: []u32,
+    head: usize = 0,
+    len: usize = 0,
+
+    pub fn init(items: []u32) RingBuffer {
+        return .{ .items = items };
+    }
+
+    pub fn push(self: *RingBuffer, value: u32) BufferError!void {
+        if (self.len == self.items.len) return error.Full;
+        self.items[(self.head + self.len) % self.items.len] = value;
+        self.len += 1;
+    }
+
+    pub fn pop(self: *RingBuffer) BufferError!u32 {
+        if (self.len == 0) return error.Empty;
+        const value = self.items[self.head];
+        self.head = (self.head + 1) % self.items.len;
+        self.len -= 1;
+        return value;
+    }
+};
+
+pub const Priority = enum {
+    low,
+    normal,
+    high,
+};
+
+/// Allocates a buffer with room for `capacity` items.
+pub fn create(allocator: Allocator, capacity: usize) !RingBuffer {
+    const items = try allocator.alloc(u32, capacity);
+    return RingBuffer.init(items);
+}
+
+fn wrap(index: usize, len: usize) usize {
+    return index % len;
+}
+
+test "push and pop preserve order" {
+    var storage: [4]u32 = undefined;
+    var rb = RingBuffer.init(&storage);
+    try rb.push(1);
+    try rb.push(2);
+    try std.testing.expectEqual(@as(u32, 1), try rb.pop());
+}
+
+test "pop on empty buffer fails" {
+    var storage: [1]u32 = undefined;
+    var rb = RingBuffer.init(&storage);
+    try std.testing.expectError(error.Empty, rb.pop());
+}